@@ -0,0 +1,97 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "math/bits"
+
+// EncodeAlphabet returns uuid encoded as a number in the base of the length of
+// alphabet, using the bytes of alphabet as digits, for short codes in a
+// product specific alphabet that are backed by real UUIDs.  For instance, the
+// NanoID alphabet
+//
+//	"_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+//
+// encodes a UUID in 22 characters.  All UUIDs are encoded in the same number
+// of characters, padded with the first byte of alphabet, the smallest number
+// that can represent every UUID, so distinct UUIDs have distinct encodings.
+//
+// EncodeAlphabet panics if alphabet has fewer than 2 bytes or a repeated
+// byte.
+func EncodeAlphabet(uuid UUID, alphabet string) string {
+	checkAlphabet(alphabet)
+	base := uint64(len(alphabet))
+	buf := make([]byte, alphabetLen(base))
+	hi, lo := uint128(uuid)
+	for i := len(buf) - 1; i >= 0; i-- {
+		var r uint64
+		hi, r = bits.Div64(0, hi, base)
+		lo, r = bits.Div64(r, lo, base)
+		buf[i] = alphabet[r]
+	}
+	return string(buf)
+}
+
+// DecodeAlphabet decodes s as encoded by EncodeAlphabet with alphabet.  It
+// panics as EncodeAlphabet does for an invalid alphabet.
+func DecodeAlphabet(s, alphabet string) (UUID, error) {
+	checkAlphabet(alphabet)
+	base := uint64(len(alphabet))
+	if len(s) != alphabetLen(base) {
+		return Nil, invalidLengthError{len(s)}
+	}
+	var digits [256]int16
+	for i := range digits {
+		digits[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		digits[alphabet[i]] = int16(i)
+	}
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		d := digits[s[i]]
+		if d < 0 {
+			return Nil, ErrInvalidUUIDFormat
+		}
+		h, l := bits.Mul64(lo, base)
+		overflow, h2 := bits.Mul64(hi, base)
+		var c uint64
+		hi, c = bits.Add64(h, h2, 0)
+		if overflow != 0 || c != 0 {
+			return Nil, ErrInvalidUUIDFormat
+		}
+		lo, c = bits.Add64(l, uint64(d), 0)
+		if hi, c = bits.Add64(hi, 0, c); c != 0 {
+			return Nil, ErrInvalidUUIDFormat
+		}
+	}
+	return fromUint128(hi, lo), nil
+}
+
+// checkAlphabet panics if alphabet is not a valid alphabet for
+// EncodeAlphabet.
+func checkAlphabet(alphabet string) {
+	if len(alphabet) < 2 || len(alphabet) > 256 {
+		panic("uuid: alphabet must have 2 to 256 bytes")
+	}
+	var seen [256]bool
+	for i := 0; i < len(alphabet); i++ {
+		if seen[alphabet[i]] {
+			panic("uuid: alphabet has repeated byte " + alphabet[i:i+1])
+		}
+		seen[alphabet[i]] = true
+	}
+}
+
+// alphabetLen returns the number of digits in base needed to represent all
+// UUIDs, the number of digits of Max.
+func alphabetLen(base uint64) int {
+	n := 0
+	for hi, lo := ^uint64(0), ^uint64(0); hi != 0 || lo != 0; n++ {
+		var r uint64
+		hi, r = bits.Div64(0, hi, base)
+		lo, _ = bits.Div64(r, lo, base)
+	}
+	return n
+}
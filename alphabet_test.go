@@ -0,0 +1,57 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+const nanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func TestEncodeAlphabet(t *testing.T) {
+	for _, tt := range []struct {
+		alphabet string
+		len      int
+	}{
+		{"01", 128},
+		{"0123456789abcdef", 32},
+		{"0123456789", 39},
+		{nanoIDAlphabet, 22},
+		{"ABCDEFGHJKMNPQRSTVWXYZ23456789", 27},
+	} {
+		for _, uuid := range []UUID{Nil, Max, NameSpaceDNS, Must(NewRandom())} {
+			s := EncodeAlphabet(uuid, tt.alphabet)
+			if len(s) != tt.len {
+				t.Errorf("EncodeAlphabet(%s, %q) = %q of length %d, want %d", uuid, tt.alphabet, s, len(s), tt.len)
+			}
+			got, err := DecodeAlphabet(s, tt.alphabet)
+			if err != nil || got != uuid {
+				t.Errorf("DecodeAlphabet(%q, %q) = %s, %v, want %s", s, tt.alphabet, got, err, uuid)
+			}
+		}
+	}
+	if s := EncodeAlphabet(NameSpaceDNS, "0123456789abcdef"); s != strings.Replace(NameSpaceDNS.String(), "-", "", -1) {
+		t.Errorf("hex alphabet gave %s", s)
+	}
+}
+
+func TestDecodeAlphabetErrors(t *testing.T) {
+	for _, s := range []string{"", "999999999999999999999999999999999999999", "0000000000000000000000000000000000000x0"} {
+		if _, err := DecodeAlphabet(s, "0123456789"); err == nil {
+			t.Errorf("DecodeAlphabet(%q) succeeded", s)
+		}
+	}
+	for _, alphabet := range []string{"", "a", "abca"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("EncodeAlphabet with alphabet %q did not panic", alphabet)
+				}
+			}()
+			EncodeAlphabet(Nil, alphabet)
+		}()
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import "iter"
+
+// Analyze returns the Stats of ids, for instance to audit whether an upstream
+// system really sends Version 4 UUIDs, and not low entropy fakes:
+//
+//	s := uuid.Analyze(slices.Values(ids))
+//	if s.Versions[4] != s.Count || s.Duplicates > 0 || s.Entropy < 0.9 {
+//		...
+//	}
+//
+// The entropy is estimated from the random bits of the Version 4 and 7 UUIDs.
+// With few such UUIDs, the estimate is low even for a sound random source: a
+// few hundred are needed for an estimate above 0.99.  Analyze keeps all the
+// distinct UUIDs of ids in memory to count the duplicates.
+func Analyze(ids iter.Seq[UUID]) Stats {
+	var a analyzer
+	for u := range ids {
+		a.add(u)
+	}
+	return a.finish()
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAnalyze(t *testing.T) {
+	var ids []UUID
+	for i := 0; i < 1000; i++ {
+		ids = append(ids, Must(NewRandom()))
+	}
+	v7 := Must(NewV7())
+	ids = append(ids, v7, v7, NameSpaceDNS, Nil)
+	s := Analyze(slices.Values(ids))
+	if s.Count != 1004 || s.Duplicates != 1 || s.Versions[4] != 1000 || s.Versions[7] != 2 || s.Versions[1] != 1 || s.OtherVariants != 1 {
+		t.Errorf("got %+v", s)
+	}
+	if s.Entropy < 0.99 {
+		t.Errorf("got entropy %f of random UUIDs, want at least 0.99", s.Entropy)
+	}
+	want := time.Unix(NameSpaceDNS.Time().UnixTime())
+	if !s.Oldest.Equal(want) || s.Spread() <= 0 {
+		t.Errorf("got oldest %v and spread %v, want oldest %v", s.Oldest, s.Spread(), want)
+	}
+
+	// Version 4 UUIDs of a counter.
+	ids = ids[:0]
+	for i := 0; i < 1000; i++ {
+		var u UUID
+		u[15] = byte(i)
+		u[14] = byte(i >> 8)
+		u[6] = 0x40
+		u[8] = 0x80
+		ids = append(ids, u)
+	}
+	if s := Analyze(slices.Values(ids)); s.Entropy > 0.2 || s.Versions[4] != 1000 {
+		t.Errorf("got %+v for a counter", s)
+	}
+
+	if s := Analyze(slices.Values([]UUID{})); s.Count != 0 || s.Entropy != 0 || s.Spread() != 0 {
+		t.Errorf("got %+v for no UUIDs", s)
+	}
+}
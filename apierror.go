@@ -0,0 +1,107 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The codes of APIErrors.
+const (
+	CodeEmpty            = "empty"
+	CodeInvalidLength    = "invalid_length"
+	CodeInvalidCharacter = "invalid_character"
+	CodeMissingHyphen    = "missing_hyphen"
+	CodeMisplacedHyphen  = "misplaced_hyphen"
+	CodeInvalidPrefix    = "invalid_prefix"
+	CodeUnbalancedBraces = "unbalanced_braces"
+)
+
+// An APIError describes why a string is not a UUID in terms meant for the
+// users of an API, so that API gateways can return actionable errors, for
+// instance as the JSON body of a 400 response:
+//
+//	{"code":"missing_hyphen","message":"missing hyphen at position 8","position":8,"hint":"..."}
+//
+// Position is the byte offset in the string of the error, from 0.
+type APIError struct {
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Position int    `json:"position"`
+	Hint     string `json:"hint,omitempty"`
+}
+
+// Error returns the message of e.
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+const apiExample = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// ParseAPIError returns an APIError describing the first error in s, or nil
+// if s is a UUID accepted by Parse.  As Parse, it accepts 38 bytes holding a
+// UUID between any two bytes, such as (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx),
+// and only reports unbalanced braces for strings of other lengths.
+func ParseAPIError(s string) *APIError {
+	if s == "" {
+		return &APIError{CodeEmpty, "empty UUID", 0, "a UUID has the form " + apiExample}
+	}
+	body, off := s, 0
+	switch {
+	case len(s) >= 9 && strings.EqualFold(s[:9], "urn:uuid:"):
+		body, off = s[9:], 9
+	case len(s) >= 4 && strings.EqualFold(s[:4], "urn:"):
+		return &APIError{CodeInvalidPrefix, fmt.Sprintf("invalid URN prefix %q", s[:4]), 0, "the URN of a UUID starts with urn:uuid:"}
+	case len(s) == 36+2:
+		body, off = s[1:37], 1
+	case s[0] == '{':
+		if s[len(s)-1] != '}' {
+			return &APIError{CodeUnbalancedBraces, "missing closing brace", len(s), "a braced UUID ends with }"}
+		}
+		body, off = s[1:len(s)-1], 1
+	case s[len(s)-1] == '}':
+		return &APIError{CodeUnbalancedBraces, "missing opening brace", 0, "a braced UUID starts with {"}
+	}
+
+	hyphens := strings.Count(body, "-")
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		hyphenAt := hyphens > 0 && (i == 8 || i == 13 || i == 18 || i == 23)
+		switch {
+		case c == '-' && !hyphenAt:
+			return &APIError{CodeMisplacedHyphen, fmt.Sprintf("unexpected hyphen at position %d", off+i), off + i,
+				"hyphens separate groups of 8, 4, 4, 4 and 12 hex digits"}
+		case c == '-':
+		case xvalues[c] == 255:
+			r, _ := utf8.DecodeRuneInString(body[i:])
+			return &APIError{CodeInvalidCharacter, fmt.Sprintf("invalid character %q at position %d", r, off+i), off + i,
+				"a UUID holds only the hex digits 0 to 9 and a to f, and hyphens"}
+		case hyphenAt:
+			return &APIError{CodeMissingHyphen, fmt.Sprintf("missing hyphen at position %d", off+i), off + i,
+				"a UUID has the form " + apiExample}
+		}
+	}
+	digits := len(body) - hyphens
+	if digits != 32 {
+		return &APIError{CodeInvalidLength, fmt.Sprintf("UUID has %d hex digits, want 32", digits), off + len(body),
+			"a UUID has the form " + apiExample}
+	}
+	// Only the standard form may omit the hyphens, not its URN and wrapped
+	// forms.
+	if hyphens > 0 || off > 0 {
+		for _, i := range [4]int{8, 13, 18, 23} {
+			if i >= len(body) || body[i] != '-' {
+				return &APIError{CodeMissingHyphen, fmt.Sprintf("missing hyphen at position %d", off+i), off + i,
+					"a UUID has the form " + apiExample}
+			}
+		}
+	}
+	if _, err := Parse(s); err != nil {
+		return &APIError{CodeInvalidCharacter, err.Error(), 0, "a UUID has the form " + apiExample}
+	}
+	return nil
+}
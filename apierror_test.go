@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseAPIError(t *testing.T) {
+	const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	for _, ok := range []string{s, strings.ToUpper(s), "urn:uuid:" + s, "{" + s + "}", "(" + s + ")", "[" + s + "]", strings.Replace(s, "-", "", -1)} {
+		if e := ParseAPIError(ok); e != nil {
+			t.Errorf("ParseAPIError(%q) = %+v, want nil", ok, e)
+		}
+		if _, err := Parse(ok); err != nil {
+			t.Errorf("Parse(%q): %v", ok, err)
+		}
+	}
+	for _, tt := range []struct {
+		in   string
+		code string
+		pos  int
+	}{
+		{"", CodeEmpty, 0},
+		{s[:8] + s[9:], CodeMissingHyphen, 8},
+		{s[:35], CodeInvalidLength, 35},
+		{s + "0", CodeInvalidLength, 37},
+		{s[:8] + "x" + s[9:], CodeInvalidCharacter, 8},
+		{s[:20] + "é" + s[22:], CodeInvalidCharacter, 20},
+		{s[:8] + "0" + s[9:], CodeMissingHyphen, 8},
+		{s[:13] + s[14:] + "0", CodeMissingHyphen, 13},
+		{s[:7] + "-" + s[7:8] + s[9:], CodeMisplacedHyphen, 7},
+		{"urn:uuid:" + strings.Replace(s, "-", "", -1), CodeMissingHyphen, 17},
+		{"{" + strings.Replace(s, "-", "", -1) + "}", CodeMissingHyphen, 9},
+		{"urn:guid:" + s, CodeInvalidPrefix, 0},
+		{"(" + s[:8] + "x" + s[9:] + ")", CodeInvalidCharacter, 9},
+		{"{" + s, CodeUnbalancedBraces, 37},
+		{s + "}", CodeUnbalancedBraces, 0},
+	} {
+		e := ParseAPIError(tt.in)
+		if e == nil {
+			t.Errorf("ParseAPIError(%q) = nil, want %s", tt.in, tt.code)
+			continue
+		}
+		if e.Code != tt.code || e.Position != tt.pos {
+			t.Errorf("ParseAPIError(%q) = %s at %d (%s), want %s at %d", tt.in, e.Code, e.Position, e.Message, tt.code, tt.pos)
+		}
+		if _, err := Parse(tt.in); err == nil {
+			t.Errorf("Parse(%q) succeeded", tt.in)
+		}
+	}
+
+	b, _ := json.Marshal(ParseAPIError(s[:8] + "0" + s[9:]))
+	if want := `{"code":"missing_hyphen","message":"missing hyphen at position 8","position":8,"hint":"a UUID has the form ` + s + `"}`; string(b) != want {
+		t.Errorf("got JSON %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ValuerSlice returns ids as driver values, for the arguments of bulk inserts
+// and IN lists.  Each UUID is a string in the standard form, as returned by
+// Value, or, if binary is true, a []byte of its 16 bytes, for BYTEA, BLOB(16)
+// and RAW(16) columns.
+func ValuerSlice(ids []UUID, binary bool) []driver.Value {
+	vs := make([]driver.Value, len(ids))
+	for i, id := range ids {
+		if binary {
+			b := make([]byte, 16)
+			copy(b, id[:])
+			vs[i] = b
+		} else {
+			vs[i] = id.String()
+		}
+	}
+	return vs
+}
+
+// UUIDArray is a list of UUIDs stored in Postgres as a uuid[], like the
+// arrays of pq.Array, so that a list of UUIDs can be passed as a single
+// argument, or scanned from a single column:
+//
+//	rows, err := db.Query("SELECT name FROM foo WHERE id = ANY($1)", uuid.UUIDArray(ids))
+//	...
+//	var ids uuid.UUIDArray
+//	err := db.QueryRow("SELECT array_agg(id) FROM foo").Scan(&ids)
+type UUIDArray []UUID
+
+// oidUUID is the Postgres type OID of uuid.
+const oidUUID = 2950
+
+// errArrayNull is returned when scanning an array with NULL elements.
+var errArrayNull = errors.New("Scan: NULL element in UUID array")
+
+// Value implements sql.Valuer.  The value of a UUIDArray is a Postgres array
+// literal, {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,...}, or NULL for a nil
+// UUIDArray.
+func (a UUIDArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	if len(a) == 0 {
+		return "{}", nil
+	}
+	b := make([]byte, 37*len(a)+1)
+	for i, u := range a {
+		b[37*i] = ','
+		encodeHex(b[37*i+1:], u)
+	}
+	b[0] = '{'
+	b[len(b)-1] = '}'
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner.  Both the text form of Postgres arrays, such as
+// {6ba7b810-9dad-11d1-80b4-00c04fd430c8,...}, and their binary form, as
+// returned by pgx for binary results, are accepted.  Only one dimensional
+// arrays without NULL elements can be scanned.  Scanning NULL sets a to nil.
+func (a *UUIDArray) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		return a.scanText(src)
+	case []byte:
+		if len(src) > 0 && (src[0] == '{' || src[0] == '[') {
+			return a.scanText(string(src))
+		}
+		return a.scanBinary(src)
+	}
+	return fmt.Errorf("Scan: unable to scan type %T into UUIDArray", src)
+}
+
+// scanText scans the text form of an array, optionally preceded by its
+// bounds, as in [0:1]={...}.
+func (a *UUIDArray) scanText(s string) error {
+	if strings.HasPrefix(s, "[") {
+		i := strings.Index(s, "]=")
+		if i < 0 || strings.Contains(s[:i], "][") {
+			return fmt.Errorf("Scan: invalid UUID array %q", s)
+		}
+		s = s[i+2:]
+	}
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return fmt.Errorf("Scan: invalid UUID array %q", s)
+	}
+	s = s[1 : len(s)-1]
+	if strings.ContainsAny(s, "{}") {
+		return errors.New("Scan: UUID array of more than one dimension")
+	}
+	if s == "" {
+		*a = UUIDArray{}
+		return nil
+	}
+	elems := strings.Split(s, ",")
+	r := make(UUIDArray, len(elems))
+	for i, e := range elems {
+		if e == "NULL" {
+			return errArrayNull
+		}
+		if len(e) >= 2 && e[0] == '"' && e[len(e)-1] == '"' {
+			e = e[1 : len(e)-1]
+		}
+		u, err := Parse(e)
+		if err != nil {
+			return fmt.Errorf("Scan: %v", err)
+		}
+		r[i] = u
+	}
+	*a = r
+	return nil
+}
+
+// scanBinary scans the binary form of an array: the number of dimensions, a
+// flag set if the array has NULL elements, the OID of the type of the
+// elements and the size and lower bound of each dimension, followed by the
+// elements, each preceded by its size, all integers being 32 bit big endian.
+func (a *UUIDArray) scanBinary(b []byte) error {
+	errInvalid := errors.New("Scan: invalid binary UUID array")
+	if len(b) < 12 {
+		return errInvalid
+	}
+	ndim := binary.BigEndian.Uint32(b[0:])
+	oid := binary.BigEndian.Uint32(b[8:])
+	if ndim == 0 {
+		*a = UUIDArray{}
+		return nil
+	}
+	if ndim != 1 {
+		return fmt.Errorf("Scan: UUID array of %d dimensions", ndim)
+	}
+	if oid != oidUUID {
+		return fmt.Errorf("Scan: array of type OID %d is not a UUID array", oid)
+	}
+	if len(b) < 20 {
+		return errInvalid
+	}
+	n := binary.BigEndian.Uint32(b[12:])
+	b = b[20:]
+	if uint64(len(b)) < uint64(n)*4 {
+		return errInvalid
+	}
+	r := make(UUIDArray, n)
+	for i := range r {
+		if len(b) < 4 {
+			return errInvalid
+		}
+		switch binary.BigEndian.Uint32(b) {
+		case 16:
+		case 0xffffffff:
+			return errArrayNull
+		default:
+			return errInvalid
+		}
+		if len(b) < 20 {
+			return errInvalid
+		}
+		copy(r[i][:], b[4:20])
+		b = b[20:]
+	}
+	if len(b) != 0 {
+		return errInvalid
+	}
+	*a = r
+	return nil
+}
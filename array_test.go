@@ -0,0 +1,129 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestValuerSlice(t *testing.T) {
+	ids := []UUID{NameSpaceDNS, NameSpaceURL}
+	for i, v := range ValuerSlice(ids, false) {
+		if s, ok := v.(string); !ok || s != ids[i].String() {
+			t.Errorf("ValuerSlice(false)[%d] = %v, want %s", i, v, ids[i])
+		}
+	}
+	vs := ValuerSlice(ids, true)
+	for i, v := range vs {
+		if b, ok := v.([]byte); !ok || !bytes.Equal(b, ids[i][:]) {
+			t.Errorf("ValuerSlice(true)[%d] = %v, want the bytes of %s", i, v, ids[i])
+		}
+	}
+	vs[0].([]byte)[0] = 0
+	if ids[0] != NameSpaceDNS {
+		t.Errorf("ValuerSlice returned the bytes of ids")
+	}
+	if vs := ValuerSlice(nil, true); len(vs) != 0 {
+		t.Errorf("ValuerSlice(nil) = %v, want no values", vs)
+	}
+}
+
+func TestUUIDArrayValue(t *testing.T) {
+	for _, tt := range []struct {
+		a    UUIDArray
+		want interface{}
+	}{
+		{nil, nil},
+		{UUIDArray{}, "{}"},
+		{UUIDArray{NameSpaceDNS}, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{UUIDArray{NameSpaceDNS, Nil}, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8,00000000-0000-0000-0000-000000000000}"},
+	} {
+		v, err := tt.a.Value()
+		if err != nil || v != tt.want {
+			t.Errorf("%v.Value() = %v, %v, want %v", tt.a, v, err, tt.want)
+		}
+	}
+}
+
+// binaryArray returns ids in the binary form of a Postgres uuid[], with a
+// NULL element for each Nil UUID.
+func binaryArray(ids ...UUID) []byte {
+	var n [4]byte
+	put := func(b []byte, v uint32) []byte {
+		binary.BigEndian.PutUint32(n[:], v)
+		return append(b, n[:]...)
+	}
+	b := []byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0x0b, 0x86}
+	b = put(b, uint32(len(ids)))
+	b = put(b, 1)
+	for _, id := range ids {
+		if id == Nil {
+			b = put(b, 0xffffffff)
+			continue
+		}
+		b = put(b, 16)
+		b = append(b, id[:]...)
+	}
+	return b
+}
+
+func TestUUIDArrayScan(t *testing.T) {
+	ids := UUIDArray{NameSpaceDNS, NameSpaceURL}
+	text, _ := ids.Value()
+	for _, tt := range []struct {
+		src  interface{}
+		want UUIDArray
+	}{
+		{nil, nil},
+		{"{}", UUIDArray{}},
+		{text, ids},
+		{[]byte(text.(string)), ids},
+		{`{"6ba7b810-9dad-11d1-80b4-00c04fd430c8",6ba7b811-9dad-11d1-80b4-00c04fd430c8}`, ids},
+		{"[0:1]=" + text.(string), ids},
+		{binaryArray(ids...), ids},
+		{binaryArray(), UUIDArray{}},
+		{[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x0b, 0x86}, UUIDArray{}},
+	} {
+		var a UUIDArray
+		if err := a.Scan(tt.src); err != nil {
+			t.Errorf("Scan(%v): %v", tt.src, err)
+			continue
+		}
+		if (a == nil) != (tt.want == nil) || len(a) != len(tt.want) {
+			t.Errorf("Scan(%v) = %v, want %v", tt.src, a, tt.want)
+			continue
+		}
+		for i := range a {
+			if a[i] != tt.want[i] {
+				t.Errorf("Scan(%v) = %v, want %v", tt.src, a, tt.want)
+				break
+			}
+		}
+	}
+
+	textArray := binaryArray(ids...)
+	textArray[11] = 25
+	for _, src := range []interface{}{
+		42,
+		"",
+		"{",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8,NULL}",
+		"{{6ba7b810-9dad-11d1-80b4-00c04fd430c8}}",
+		"[0:0][0:0]={{6ba7b810-9dad-11d1-80b4-00c04fd430c8}}",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8,}",
+		binaryArray(NameSpaceDNS, Nil),
+		binaryArray(ids...)[:40],
+		append(binaryArray(ids...), 0),
+		textArray,
+		[]byte{0, 0, 0, 1},
+	} {
+		var a UUIDArray
+		if err := a.Scan(src); err == nil {
+			t.Errorf("Scan(%v) succeeded: %v", src, a)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewV7Backfill(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	at := time.Date(2019, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	u := NewV7Backfill(at, salt)
+	if u.Version() != 7 || u.Variant() != RFC4122 {
+		t.Fatalf("NewV7Backfill returned %s of version %s and variant %s", u, u.Version(), u.Variant())
+	}
+	if u2 := NewV7Backfill(at, salt); u2 != u {
+		t.Errorf("NewV7Backfill is not reproducible: %s != %s", u2, u)
+	}
+	if u2 := NewV7Backfill(at, []byte("other salt")); u2 == u {
+		t.Errorf("NewV7Backfill ignores the salt")
+	}
+	if ms := TimeToUnixMilli(at); u.Time() != Time(ms*10000+GregorianOffset) {
+		t.Errorf("got time %d, want %d", u.Time(), ms*10000+GregorianOffset)
+	}
+	if later := NewV7Backfill(at.Add(time.Microsecond), salt); Compare(u, later) >= 0 {
+		t.Errorf("UUID of a later time in the same millisecond %s is not after %s", later, u)
+	}
+}
+
+func TestBackfill(t *testing.T) {
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	at := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	times := []time.Time{at, at, at, at.Add(time.Second), at.Add(time.Second)}
+
+	run := func() []UUID {
+		b := NewBackfill(salt)
+		var ids []UUID
+		for _, t := range times {
+			ids = append(ids, b.NewV7(t))
+		}
+		return ids
+	}
+	ids := run()
+	seen := map[UUID]bool{}
+	for i, u := range ids {
+		if seen[u] {
+			t.Errorf("duplicate UUID %s at #%d", u, i)
+		}
+		seen[u] = true
+	}
+	if ids[0] != NewV7Backfill(at, salt) || ids[3] != NewV7Backfill(at.Add(time.Second), salt) {
+		t.Errorf("first UUIDs of each time differ from those of NewV7Backfill")
+	}
+	for i, u := range run() {
+		if u != ids[i] {
+			t.Errorf("replay returned %s at #%d, want %s", u, i, ids[i])
+		}
+	}
+}
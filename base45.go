@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// base45 is the alphabet of the Base45 encoding of RFC 9285, the characters
+// of the alphanumeric mode of QR codes.
+const base45 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+
+// base45Values maps the bytes of base45 to their values, and all other bytes
+// to 255.
+var base45Values = func() (v [256]byte) {
+	for i := range v {
+		v[i] = 255
+	}
+	for i := 0; i < len(base45); i++ {
+		v[base45[i]] = byte(i)
+	}
+	return v
+}()
+
+// EncodeBase45 returns uuid in the Base45 encoding of RFC 9285, as 24
+// characters of the alphanumeric mode of QR codes, such as
+// " +U9IO7ABVN8/+KVY1/QMX%Q" for f47ac10b-58cc-4372-a567-0e02b2c3d479.  QR
+// codes store alphanumeric characters in 5.5 bits, against 8 bits for the
+// lower case standard form, so that the QR code of a UUID on a device label
+// is smallest with EncodeBase45.  The encoding may contain spaces and does
+// not sort as the UUIDs do.
+func (uuid UUID) EncodeBase45() string {
+	var buf [24]byte
+	for i := 0; i < 16; i += 2 {
+		n := int(uuid[i])<<8 | int(uuid[i+1])
+		j := i / 2 * 3
+		buf[j] = base45[n%45]
+		buf[j+1] = base45[n/45%45]
+		buf[j+2] = base45[n/(45*45)]
+	}
+	return string(buf[:])
+}
+
+// ParseBase45 decodes s as encoded by EncodeBase45.  As QR codes, it only
+// accepts upper case letters.
+func ParseBase45(s string) (UUID, error) {
+	var uuid UUID
+	if len(s) != 24 {
+		return Nil, invalidLengthError{len(s)}
+	}
+	for i := 0; i < 16; i += 2 {
+		j := i / 2 * 3
+		c, d, e := base45Values[s[j]], base45Values[s[j+1]], base45Values[s[j+2]]
+		if c == 255 || d == 255 || e == 255 {
+			return Nil, ErrInvalidUUIDFormat
+		}
+		n := int(c) + int(d)*45 + int(e)*45*45
+		if n > 0xffff {
+			return Nil, ErrInvalidUUIDFormat
+		}
+		uuid[i], uuid[i+1] = byte(n>>8), byte(n)
+	}
+	return uuid, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestBase45(t *testing.T) {
+	for _, u := range []UUID{Nil, Max, testUUID, MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")} {
+		s := u.EncodeBase45()
+		if len(s) != 24 {
+			t.Errorf("EncodeBase45(%s) = %q, want 24 characters", u, s)
+		}
+		for i := 0; i < len(s); i++ {
+			if base45Values[s[i]] == 255 {
+				t.Errorf("EncodeBase45(%s) = %q, not in the alphabet", u, s)
+			}
+		}
+		got, err := ParseBase45(s)
+		if err != nil || got != u {
+			t.Errorf("ParseBase45(%q) = %s, %v, want %s", s, got, err, u)
+		}
+	}
+
+	// "AB" is "BB8" in RFC 9285, Section 4.3.
+	u := Nil
+	u[0], u[1] = 'A', 'B'
+	if s := u.EncodeBase45(); s[:3] != "BB8" {
+		t.Errorf("EncodeBase45 of AB = %q, want BB8", s[:3])
+	}
+	if s := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479").EncodeBase45(); s != " +U9IO7ABVN8/+KVY1/QMX%Q" {
+		t.Errorf("EncodeBase45 = %q, want %q", s, " +U9IO7ABVN8/+KVY1/QMX%Q")
+	}
+	if s := Max.EncodeBase45(); s[:3] != "FGW" {
+		t.Errorf("EncodeBase45 of ffff = %q, want FGW", s[:3])
+	}
+
+	for _, s := range []string{
+		"",
+		"000000000000000000000000 ",
+		"00000000000000000000000a",
+		"GGW000000000000000000000",
+		":::000000000000000000000",
+	} {
+		if u, err := ParseBase45(s); err == nil {
+			t.Errorf("ParseBase45(%q) = %s, want an error", s, u)
+		}
+	}
+}
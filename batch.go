@@ -0,0 +1,57 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseSlice parses each string of ss as Parse does.  If a string cannot be
+// parsed ParseSlice returns nil and an error wrapping the error of Parse.
+func ParseSlice(ss []string) ([]UUID, error) {
+	uuids := make([]UUID, len(ss))
+	for i, s := range ss {
+		var err error
+		if uuids[i], err = Parse(s); err != nil {
+			return nil, fmt.Errorf("ParseSlice: index %d: %w", i, err)
+		}
+	}
+	return uuids, nil
+}
+
+// FormatSlice returns the string form of each UUID of uuids in style.  All
+// strings are backed by a single allocation, so FormatSlice is considerably
+// faster than calling String for each UUID.  Retaining any of the strings
+// retains the memory of all of them.
+func FormatSlice(uuids []UUID, style Style) []string {
+	n := style.size()
+	var b strings.Builder
+	b.Grow(len(uuids) * n)
+	var buf [36 + 9]byte
+	for _, uuid := range uuids {
+		encodeStyle(buf[:n], uuid, style)
+		b.Write(buf[:n])
+	}
+	s := b.String()
+	ss := make([]string, len(uuids))
+	for i := range ss {
+		ss[i] = s[i*n : (i+1)*n]
+	}
+	return ss
+}
+
+// FormatSliceBytes is like FormatSlice but returns byte slices, all of which
+// share a single buffer.
+func FormatSliceBytes(uuids []UUID, style Style) [][]byte {
+	n := style.size()
+	buf := make([]byte, len(uuids)*n)
+	bs := make([][]byte, len(uuids))
+	for i, uuid := range uuids {
+		bs[i] = buf[i*n : (i+1)*n : (i+1)*n]
+		encodeStyle(bs[i], uuid, style)
+	}
+	return bs
+}
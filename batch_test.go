@@ -0,0 +1,82 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestFormatSlice(t *testing.T) {
+	uuids := []UUID{NameSpaceDNS, NameSpaceURL, Max}
+	for _, tt := range []struct {
+		style  Style
+		format func(UUID) string
+	}{
+		{StyleCanonical, UUID.String},
+		{StyleURN, UUID.URN},
+		{StyleBraced, func(u UUID) string { return "{" + u.String() + "}" }},
+		{StyleHex, func(u UUID) string { return fmt.Sprintf("%x", u[:]) }},
+		{Style(42), UUID.String},
+	} {
+		ss := FormatSlice(uuids, tt.style)
+		bs := FormatSliceBytes(uuids, tt.style)
+		for i, uuid := range uuids {
+			want := tt.format(uuid)
+			if ss[i] != want {
+				t.Errorf("FormatSlice style %d #%d: got %q, want %q", tt.style, i, ss[i], want)
+			}
+			if string(bs[i]) != want {
+				t.Errorf("FormatSliceBytes style %d #%d: got %q, want %q", tt.style, i, bs[i], want)
+			}
+		}
+	}
+	if ss := FormatSlice(nil, StyleCanonical); len(ss) != 0 {
+		t.Errorf("FormatSlice(nil) returned %d strings", len(ss))
+	}
+}
+
+func TestParseSlice(t *testing.T) {
+	uuids := []UUID{NameSpaceDNS, NameSpaceURL, Max}
+	got, err := ParseSlice(FormatSlice(uuids, StyleURN))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range uuids {
+		if got[i] != uuids[i] {
+			t.Errorf("#%d: got %s, want %s", i, got[i], uuids[i])
+		}
+	}
+	got, err = ParseSlice([]string{NameSpaceDNS.String(), "x"})
+	if got != nil || !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("ParseSlice got (%v, %v), want (nil, %v)", got, err, ErrInvalidLength)
+	}
+}
+
+func BenchmarkFormatSlice(b *testing.B) {
+	uuids := make([]UUID, 1000)
+	for i := range uuids {
+		uuids[i] = New()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FormatSlice(uuids, StyleCanonical)
+	}
+}
+
+func BenchmarkParseSlice(b *testing.B) {
+	uuids := make([]UUID, 1000)
+	for i := range uuids {
+		uuids[i] = New()
+	}
+	ss := FormatSlice(uuids, StyleCanonical)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseSlice(ss); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ReverseBits returns uuid with the order of its 128 bits reversed.
+// ReverseBits is an involution: ReverseBits(ReverseBits(uuid)) == uuid.
+//
+// Time ordered UUIDs, such as Version 7 UUIDs, are the preferred primary keys
+// of B-tree indexes of a single node: consecutive inserts land in the same
+// rightmost pages, which stay cached, and range scans by time read adjacent
+// pages.  The same locality concentrates all writes on a single node of a
+// range partitioned store, or on a single hot page under heavy concurrent
+// inserts.  Storing ReverseBits(uuid) instead moves the fastest changing low
+// bits of the time to the front, spreading consecutive keys evenly over the
+// key space, at the cost of range scans by time.  Apply ReverseBits again to
+// keys read back to recover the original UUIDs.
+func ReverseBits(uuid UUID) UUID {
+	hi := binary.BigEndian.Uint64(uuid[:8])
+	lo := binary.BigEndian.Uint64(uuid[8:])
+	var r UUID
+	binary.BigEndian.PutUint64(r[:8], bits.Reverse64(lo))
+	binary.BigEndian.PutUint64(r[8:], bits.Reverse64(hi))
+	return r
+}
+
+// ReverseBitsSlice applies ReverseBits to each UUID of uuids in place.
+func ReverseBitsSlice(uuids []UUID) {
+	for i := range uuids {
+		uuids[i] = ReverseBits(uuids[i])
+	}
+}
+
+// clampPrefixBits limits n to the range 0 to 64.
+func clampPrefixBits(n int) uint {
+	switch {
+	case n < 0:
+		return 0
+	case n > 64:
+		return 64
+	}
+	return uint(n)
+}
+
+// Prefix returns the leading n bits of uuid as an integer, for routing UUIDs
+// to key ranges, e.g. to one of 2^n shards.  n is limited to the range 0 to
+// 64.
+func (uuid UUID) Prefix(n int) uint64 {
+	return binary.BigEndian.Uint64(uuid[:8]) >> (64 - clampPrefixBits(n))
+}
+
+// FromPrefix returns the smallest and the largest UUID whose leading n bits
+// are the low n bits of prefix, that is, the bounds of a range scan of all
+// UUIDs with uuid.Prefix(n) == prefix.  n is limited to the range 0 to 64.
+func FromPrefix(prefix uint64, n int) (min, max UUID) {
+	b := clampPrefixBits(n)
+	hi := uint64(0)
+	if b > 0 {
+		hi = prefix << (64 - b)
+	}
+	binary.BigEndian.PutUint64(min[:8], hi)
+	binary.BigEndian.PutUint64(max[:8], hi|^uint64(0)>>b)
+	binary.BigEndian.PutUint64(max[8:], ^uint64(0))
+	return min, max
+}
+
+var (
+	// ErrInvalidBitRange is returned by SetBits for a bit range that is
+	// not within a UUID or is wider than 64 bits.
+	ErrInvalidBitRange = errors.New("invalid UUID bit range")
+
+	// ErrReservedBits is returned by SetBits for a bit range overlapping
+	// the version or variant bits.
+	ErrReservedBits = errors.New("UUID bit range overlaps version or variant")
+)
+
+// Bit offsets of the version and variant fields of a UUID.
+const (
+	versionOffset = 48
+	variantOffset = 64
+)
+
+// validBitRange reports whether the width bits at offset are within a UUID
+// and width is between 1 and 64.
+func validBitRange(offset, width int) bool {
+	return offset >= 0 && width >= 1 && width <= 64 && offset+width <= 128
+}
+
+// uint128 returns uuid as two integers.
+func uint128(uuid UUID) (hi, lo uint64) {
+	return binary.BigEndian.Uint64(uuid[:8]), binary.BigEndian.Uint64(uuid[8:])
+}
+
+// fromUint128 returns the UUID of the two integers hi and lo.
+func fromUint128(hi, lo uint64) UUID {
+	var uuid UUID
+	binary.BigEndian.PutUint64(uuid[:8], hi)
+	binary.BigEndian.PutUint64(uuid[8:], lo)
+	return uuid
+}
+
+// shr128 returns the 128 bit integer hi, lo shifted right by n bits.
+func shr128(hi, lo uint64, n uint) (uint64, uint64) {
+	if n >= 64 {
+		return 0, hi >> (n - 64)
+	}
+	return hi >> n, lo>>n | hi<<(64-n)
+}
+
+// shl128 returns the 128 bit integer hi, lo shifted left by n bits.
+func shl128(hi, lo uint64, n uint) (uint64, uint64) {
+	if n >= 64 {
+		return lo << (n - 64), 0
+	}
+	return hi<<n | lo>>(64-n), lo << n
+}
+
+// GetBits returns the width bits of uuid starting at bit offset, where bit 0
+// is the most significant bit of uuid[0], as an integer.  It panics if the
+// range is not within the UUID or width is not between 1 and 64.
+func GetBits(uuid UUID, offset, width int) uint64 {
+	if !validBitRange(offset, width) {
+		panic(fmt.Sprintf("uuid: GetBits: invalid bit range %d+%d", offset, width))
+	}
+	hi, lo := uint128(uuid)
+	_, lo = shr128(hi, lo, uint(128-offset-width))
+	return lo & (^uint64(0) >> (64 - uint(width)))
+}
+
+// SetBits returns uuid with the width bits starting at bit offset, as counted
+// by GetBits, set to the low width bits of v.  SetBits is meant for building
+// custom Version 8 layouts and refuses to modify the version bits (48 to 51)
+// and the two variant bits (64 and 65) of RFC 9562, returning
+// ErrReservedBits, and invalid bit ranges, returning ErrInvalidBitRange.
+func SetBits(uuid UUID, offset, width int, v uint64) (UUID, error) {
+	if !validBitRange(offset, width) {
+		return uuid, ErrInvalidBitRange
+	}
+	if offset < versionOffset+4 && offset+width > versionOffset ||
+		offset < variantOffset+2 && offset+width > variantOffset {
+		return uuid, ErrReservedBits
+	}
+	mask := ^uint64(0) >> (64 - uint(width))
+	shift := uint(128 - offset - width)
+	mhi, mlo := shl128(0, mask, shift)
+	vhi, vlo := shl128(0, v&mask, shift)
+	hi, lo := uint128(uuid)
+	return fromUint128(hi&^mhi|vhi, lo&^mlo|vlo), nil
+}
+
+// fromPayload returns the UUID of version v holding the 122 bit integer hi,
+// lo in the bits that are neither version nor variant.
+func fromPayload(hi, lo uint64, v byte) UUID {
+	var uuid UUID
+	_, a := shr128(hi, lo, 74)
+	_, b := shr128(hi, lo, 62)
+	uuid, _ = SetBits(uuid, 0, 48, a)
+	uuid, _ = SetBits(uuid, 52, 12, b)
+	uuid, _ = SetBits(uuid, 66, 62, lo)
+	uuid[6] = uuid[6]&0x0f | v<<4
+	uuid[8] = uuid[8]&0x3f | 0x80 // Variant is 10
+	return uuid
+}
+
+// payload returns the 122 bit integer of the bits of uuid that are neither
+// version nor variant.
+func payload(uuid UUID) (hi, lo uint64) {
+	hi, lo = shl128(0, GetBits(uuid, 0, 48), 74)
+	bhi, blo := shl128(0, GetBits(uuid, 52, 12), 62)
+	return hi | bhi, lo | blo | GetBits(uuid, 66, 62)
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestReverseBits(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000"},
+		{"80000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000001"},
+		{"00000000-0000-0000-0000-000000000003", "c0000000-0000-0000-0000-000000000000"},
+		{"01000000-0000-0000-0000-0000000000f0", "0f000000-0000-0000-0000-000000000080"},
+	} {
+		in, want := MustParse(tt.in), MustParse(tt.want)
+		if got := ReverseBits(in); got != want {
+			t.Errorf("ReverseBits(%s) = %s, want %s", in, got, want)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		uuid := Must(NewV7())
+		if got := ReverseBits(ReverseBits(uuid)); got != uuid {
+			t.Fatalf("ReverseBits is not an involution for %s: got %s", uuid, got)
+		}
+	}
+	uuids := []UUID{NameSpaceDNS, Max}
+	ReverseBitsSlice(uuids)
+	if uuids[0] != ReverseBits(NameSpaceDNS) || uuids[1] != Max {
+		t.Errorf("ReverseBitsSlice got %v", uuids)
+	}
+}
+
+func TestPrefix(t *testing.T) {
+	uuid := MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	for _, tt := range []struct {
+		n    int
+		want uint64
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 1},
+		{4, 0xf},
+		{12, 0xf47},
+		{32, 0xf47ac10b},
+		{64, 0xf47ac10b58cc4372},
+		{100, 0xf47ac10b58cc4372},
+	} {
+		if got := uuid.Prefix(tt.n); got != tt.want {
+			t.Errorf("Prefix(%d) = %#x, want %#x", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFromPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		prefix   uint64
+		n        int
+		min, max string
+	}{
+		{0, 0, "00000000-0000-0000-0000-000000000000", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+		{0xf47, 12, "f4700000-0000-0000-0000-000000000000", "f47fffff-ffff-ffff-ffff-ffffffffffff"},
+		{0x1f47, 12, "f4700000-0000-0000-0000-000000000000", "f47fffff-ffff-ffff-ffff-ffffffffffff"},
+		{0xf47ac10b58cc4372, 64, "f47ac10b-58cc-4372-0000-000000000000", "f47ac10b-58cc-4372-ffff-ffffffffffff"},
+	} {
+		min, max := FromPrefix(tt.prefix, tt.n)
+		if min != MustParse(tt.min) || max != MustParse(tt.max) {
+			t.Errorf("FromPrefix(%#x, %d) = (%s, %s), want (%s, %s)", tt.prefix, tt.n, min, max, tt.min, tt.max)
+		}
+		if min.Prefix(tt.n) != max.Prefix(tt.n) {
+			t.Errorf("FromPrefix(%#x, %d) bounds have different prefixes", tt.prefix, tt.n)
+		}
+	}
+}
+
+func TestGetBits(t *testing.T) {
+	uuid := MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	for _, tt := range []struct {
+		offset, width int
+		want          uint64
+	}{
+		{0, 4, 0xf},
+		{0, 64, 0xf47ac10b58cc4372},
+		{48, 4, 4}, // version
+		{64, 2, 2}, // variant
+		{60, 8, 0x28},
+		{64, 64, 0x85670e02b2c3d479},
+		{124, 4, 0x9},
+		{127, 1, 1},
+	} {
+		if got := GetBits(uuid, tt.offset, tt.width); got != tt.want {
+			t.Errorf("GetBits(%d, %d) = %#x, want %#x", tt.offset, tt.width, got, tt.want)
+		}
+	}
+	for _, r := range [][2]int{{-1, 4}, {0, 0}, {0, 65}, {120, 9}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("GetBits(%d, %d) did not panic", r[0], r[1])
+				}
+			}()
+			GetBits(uuid, r[0], r[1])
+		}()
+	}
+}
+
+func TestSetBits(t *testing.T) {
+	uuid := MustParse("00000000-0000-8000-8000-000000000000")
+	for _, tt := range []struct {
+		offset, width int
+		v             uint64
+		want          string
+		err           error
+	}{
+		{0, 48, 0x0123456789ab, "01234567-89ab-8000-8000-000000000000", nil},
+		{0, 4, 0x1f, "f0000000-0000-8000-8000-000000000000", nil},
+		{52, 12, 0xabc, "00000000-0000-8abc-8000-000000000000", nil},
+		{66, 62, ^uint64(0), "00000000-0000-8000-bfff-ffffffffffff", nil},
+		{60, 4, 0xf, "00000000-0000-800f-8000-000000000000", nil},
+		{44, 8, 0xff, "", ErrReservedBits},
+		{48, 4, 0x7, "", ErrReservedBits},
+		{62, 3, 0x7, "", ErrReservedBits},
+		{65, 1, 0x1, "", ErrReservedBits},
+		{120, 9, 0, "", ErrInvalidBitRange},
+		{0, 0, 0, "", ErrInvalidBitRange},
+	} {
+		got, err := SetBits(uuid, tt.offset, tt.width, tt.v)
+		if err != tt.err {
+			t.Errorf("SetBits(%d, %d, %#x) got error %v, want %v", tt.offset, tt.width, tt.v, err, tt.err)
+			continue
+		}
+		if err != nil {
+			if got != uuid {
+				t.Errorf("SetBits(%d, %d, %#x) modified the UUID on error", tt.offset, tt.width, tt.v)
+			}
+			continue
+		}
+		if got != MustParse(tt.want) {
+			t.Errorf("SetBits(%d, %d, %#x) = %s, want %s", tt.offset, tt.width, tt.v, got, tt.want)
+		}
+		if v := GetBits(got, tt.offset, tt.width); v != tt.v&(^uint64(0)>>(64-uint(tt.width))) {
+			t.Errorf("GetBits after SetBits(%d, %d, %#x) = %#x", tt.offset, tt.width, tt.v, v)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// BSON element types and the binary subtype used for UUIDs, see
+// https://bsonspec.org/spec.html.
+const (
+	bsonString      = 0x02
+	bsonBinary      = 0x05
+	bsonNull        = 0x0A
+	bsonSubtypeUUID = 0x04
+)
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of
+// go.mongodb.org/mongo-driver/v2.  The UUID is encoded as BSON binary data of
+// subtype 4 (UUID).
+func (uuid UUID) MarshalBSONValue() (byte, []byte, error) {
+	data := make([]byte, 4+1+16)
+	binary.LittleEndian.PutUint32(data, 16)
+	data[4] = bsonSubtypeUUID
+	copy(data[5:], uuid[:])
+	return bsonBinary, data, nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of
+// go.mongodb.org/mongo-driver/v2.  Binary data of subtype 4 (UUID) and
+// strings, parsed as Parse does, are supported.  A BSON null leaves uuid
+// unchanged, as Scan does for SQL NULL.
+func (uuid *UUID) UnmarshalBSONValue(typ byte, data []byte) error {
+	switch typ {
+	case bsonNull:
+		return nil
+	case bsonBinary:
+		if len(data) != 4+1+16 || binary.LittleEndian.Uint32(data) != 16 {
+			return fmt.Errorf("invalid BSON UUID (got %d bytes)", len(data))
+		}
+		if data[4] != bsonSubtypeUUID {
+			return fmt.Errorf("invalid BSON binary subtype %#02x for UUID", data[4])
+		}
+		copy(uuid[:], data[5:])
+		return nil
+	case bsonString:
+		if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+			return fmt.Errorf("invalid BSON string (got %d bytes)", len(data))
+		}
+		id, err := ParseBytes(data[4 : len(data)-1])
+		if err != nil {
+			return err
+		}
+		*uuid = id
+		return nil
+	}
+	return fmt.Errorf("unable to unmarshal BSON type %#02x into UUID", typ)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBSONValue(t *testing.T) {
+	uuid := MustParse("12345678-abcd-1234-abcd-0123456789ab")
+	typ, data, err := uuid.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte{16, 0, 0, 0, bsonSubtypeUUID}, uuid[:]...)
+	if typ != bsonBinary || !bytes.Equal(data, want) {
+		t.Errorf("MarshalBSONValue() = (%#x, %x), want (%#x, %x)", typ, data, bsonBinary, want)
+	}
+	var got UUID
+	if err := got.UnmarshalBSONValue(typ, data); err != nil || got != uuid {
+		t.Errorf("UnmarshalBSONValue got (%s, %v), want (%s, <nil>)", got, err, uuid)
+	}
+
+	s := uuid.String()
+	str := append([]byte{byte(len(s) + 1), 0, 0, 0}, s...)
+	str = append(str, 0)
+	got = Nil
+	if err := got.UnmarshalBSONValue(bsonString, str); err != nil || got != uuid {
+		t.Errorf("UnmarshalBSONValue(string) got (%s, %v), want (%s, <nil>)", got, err, uuid)
+	}
+
+	got = uuid
+	if err := got.UnmarshalBSONValue(bsonNull, nil); err != nil || got != uuid {
+		t.Errorf("UnmarshalBSONValue(null) got (%s, %v), want (%s, <nil>)", got, err, uuid)
+	}
+}
+
+func TestBSONValueErrors(t *testing.T) {
+	uuid := MustParse("12345678-abcd-1234-abcd-0123456789ab")
+	for _, tt := range []struct {
+		typ  byte
+		data []byte
+	}{
+		{bsonBinary, append([]byte{16, 0, 0, 0, 0x03}, uuid[:]...)},
+		{bsonBinary, append([]byte{15, 0, 0, 0, bsonSubtypeUUID}, uuid[1:]...)},
+		{bsonString, []byte{5, 0, 0, 0, 'x'}},
+		{bsonString, []byte{2, 0, 0, 0, 'x', 0}},
+		{0x10, []byte{1, 0, 0, 0}},
+	} {
+		var got UUID
+		if err := got.UnmarshalBSONValue(tt.typ, tt.data); err == nil {
+			t.Errorf("UnmarshalBSONValue(%#x, %x) succeeded", tt.typ, tt.data)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import (
+	"iter"
+	"time"
+)
+
+// V7Buckets returns an iterator over the bounds of the Version 7 UUIDs of
+// consecutive windows of step from from to to, as returned by V7Bounds, so
+// that batch jobs can partition table scans by time using only a Version 7
+// primary key:
+//
+//	for b := range uuid.V7Buckets(from, to, time.Hour) {
+//		rows, err := db.Query("SELECT ... WHERE id BETWEEN $1 AND $2", b[0], b[1])
+//		...
+//	}
+//
+// Each window includes its start and excludes its end, in milliseconds, so
+// that the windows do not overlap.  The last window ends at to and may be
+// shorter than step.  A step of less than a millisecond is taken as a
+// millisecond.  No windows are returned if to is not after from.
+func V7Buckets(from, to time.Time, step time.Duration) iter.Seq[[2]UUID] {
+	if step < time.Millisecond {
+		step = time.Millisecond
+	}
+	return func(yield func([2]UUID) bool) {
+		end := TimeToUnixMilli(to)
+		for t := from; TimeToUnixMilli(t) < end; t = t.Add(step) {
+			next := t.Add(step)
+			if TimeToUnixMilli(next) > end {
+				next = to
+			}
+			min, _ := V7Bounds(t, t)
+			_, max := V7Bounds(next.Add(-time.Millisecond), next.Add(-time.Millisecond))
+			if !yield([2]UUID{min, max}) {
+				return
+			}
+		}
+	}
+}
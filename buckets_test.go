@@ -0,0 +1,62 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestV7Buckets(t *testing.T) {
+	from := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+	to := from.Add(150 * time.Minute)
+	var buckets [][2]UUID
+	for b := range V7Buckets(from, to, time.Hour) {
+		buckets = append(buckets, b)
+	}
+	if len(buckets) != 3 {
+		t.Fatalf("got %d buckets, want 3", len(buckets))
+	}
+	for i, b := range buckets {
+		start := from.Add(time.Duration(i) * time.Hour)
+		if min, _ := V7Bounds(start, start); b[0] != min {
+			t.Errorf("bucket %d starts at %s, want %s", i, b[0], min)
+		}
+		if i > 0 && Compare(buckets[i-1][1], b[0]) >= 0 {
+			t.Errorf("bucket %d overlaps bucket %d", i, i-1)
+		}
+	}
+	if _, max := V7Bounds(to, to.Add(-time.Millisecond)); buckets[2][1] != max {
+		t.Errorf("last bucket ends at %s, want %s", buckets[2][1], max)
+	}
+
+	// A UUID of every bucket is within its bounds.
+	g, _ := NewGenerator()
+	for i, b := range buckets {
+		now := from.Add(time.Duration(i)*time.Hour + 59*time.Minute + 59*time.Second + 999*time.Millisecond)
+		timeNow = func() time.Time { return now }
+		u := Must(g.NewV7())
+		if i < 2 && (Compare(u, b[0]) < 0 || Compare(u, b[1]) > 0) {
+			t.Errorf("%s not in bucket %d [%s, %s]", u, i, b[0], b[1])
+		}
+	}
+	timeNow = time.Now
+
+	n := 0
+	for range V7Buckets(from, to, 0) {
+		if n++; n == 10 {
+			break
+		}
+	}
+	if n != 10 {
+		t.Errorf("iteration did not stop after break")
+	}
+	for range V7Buckets(to, from, time.Hour) {
+		t.Errorf("V7Buckets returned a bucket for an empty range")
+	}
+}
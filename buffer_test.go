@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// countingReader returns sequential bytes and counts the calls to Read.
+type countingReader struct {
+	next  byte
+	reads int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	r.reads++
+	for i := range p {
+		p[i] = r.next
+		r.next++
+	}
+	return len(p), nil
+}
+
+func TestBufferedReader(t *testing.T) {
+	r := &countingReader{}
+	b := NewBufferedReader(r, 64)
+	if b.Size() != 64 {
+		t.Errorf("Size() = %d, want 64", b.Size())
+	}
+	var got []byte
+	for i := 0; i < 10; i++ {
+		p := make([]byte, 16)
+		if _, err := io.ReadFull(b, p); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, p...)
+	}
+	for i, c := range got {
+		if c != byte(i) {
+			t.Fatalf("byte %d is %d, want %d", i, c, byte(i))
+		}
+	}
+	if r.reads != 3 {
+		t.Errorf("underlying reader read %d times, want 3", r.reads)
+	}
+
+	b.Reset()
+	p := make([]byte, 100) // larger than a block
+	if _, err := io.ReadFull(b, p); err != nil {
+		t.Fatal(err)
+	}
+	if p[0] != 192 || p[99] != 35 {
+		t.Errorf("after Reset got %d...%d, want 192...35", p[0], p[99])
+	}
+}
+
+func TestBufferedReaderUnbuffered(t *testing.T) {
+	r := &countingReader{}
+	b := NewBufferedReader(r, 0)
+	for i := 0; i < 4; i++ {
+		Must(NewRandomFromReader(b))
+	}
+	if r.reads != 4 {
+		t.Errorf("underlying reader read %d times, want 4", r.reads)
+	}
+}
+
+func TestBufferedReaderError(t *testing.T) {
+	b := NewBufferedReader(bytes.NewReader(make([]byte, 40)), 32)
+	if _, err := NewRandomFromReader(b); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRandomFromReader(b); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewRandomFromReader(b); err == nil {
+		t.Errorf("expected an error as reader has no more bytes")
+	}
+}
+
+func TestGeneratorWithBufferSize(t *testing.T) {
+	r := &countingReader{}
+	g, err := NewGenerator(WithRand(r), WithBufferSize(4096))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 256; i++ {
+		Must(g.NewV7())
+	}
+	if r.reads != 1 {
+		t.Errorf("underlying reader read %d times, want 1", r.reads)
+	}
+	if _, err := NewGenerator(FIPSOnly(), WithBufferSize(4096)); err != ErrFIPSBuffer {
+		t.Errorf("NewGenerator got error %v, want %v", err, ErrFIPSBuffer)
+	}
+	if _, err := NewGenerator(FIPSOnly(), WithBufferSize(0)); err != nil {
+		t.Errorf("NewGenerator(FIPSOnly(), WithBufferSize(0)): %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "sync"
+
+// A Cached holds a UUID and its string form, computed on the first call of
+// String and reused afterwards, for structs whose IDs are formatted in many
+// log lines or template renders.  A Cached must not be copied after first
+// use.
+//
+// A Cached is safe for concurrent use by multiple goroutines.
+type Cached struct {
+	uuid UUID
+	once sync.Once
+	s    string // set by once
+}
+
+// NewCached returns a Cached holding uuid.
+func NewCached(uuid UUID) *Cached {
+	return &Cached{uuid: uuid}
+}
+
+// UUID returns the UUID held by c.
+func (c *Cached) UUID() UUID {
+	return c.uuid
+}
+
+// String returns the string form of the UUID held by c, as returned by its
+// String method on the first call.
+func (c *Cached) String() string {
+	c.once.Do(func() {
+		c.s = c.uuid.String()
+	})
+	return c.s
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the string form
+// of the UUID held by c.
+func (c *Cached) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
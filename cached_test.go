@@ -0,0 +1,31 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestCached(t *testing.T) {
+	c := NewCached(testUUID)
+	if c.UUID() != testUUID {
+		t.Errorf("UUID() = %s, want %s", c.UUID(), testUUID)
+	}
+	if s := fmt.Sprint(c); s != testUUID.String() {
+		t.Errorf("Sprint = %s, want %s", s, testUUID)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = c.String() }); n != 0 {
+		t.Errorf("String allocates %v times", n)
+	}
+	data, err := json.Marshal(struct{ ID *Cached }{c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"ID":"` + testUUID.String() + `"}`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+}
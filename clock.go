@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "time"
+
+// SetClock sets the clock from which NewUUID, NewV6, NewV7, NewV7Batch and
+// NewV8Scrambled read the time of the UUIDs to now, instead of time.Now.
+// Fleets that smear leap seconds, for instance with a smeared NTP service or
+// a TrueTime like API, can so keep the timestamps of their UUIDs consistent
+// across hosts:
+//
+//	uuid.SetClock(smearedClock.Now)
+//
+// The times returned by now are converted as those of time.Now: to
+// milliseconds since 1 Jan 1970 for Version 7 UUIDs, and to 100s of
+// nanoseconds since 15 Oct 1582 for Version 1 and 6 UUIDs, both scales
+// ignoring leap seconds, as RFC 9562 specifies.  Only the wall time of the
+// times is used; their monotonic clock reading, if any, is ignored.  The
+// UUIDs remain strictly increasing if now goes backwards.
+//
+// Age and ValidateTimestamp compare the times of UUIDs with now as well.
+// Generators, SnowflakeGenerators and RateLimiters do not use the clock set
+// by SetClock; see WithClock for Generators.
+//
+// Calling SetClock with nil restores time.Now.  SetClock may be called
+// concurrently with the generation of UUIDs.
+func SetClock(now func() time.Time) {
+	updateConfig(func(c *config) { c.clock = now })
+}
+
+// WithClock makes the Generator read the time of its Version 7 UUIDs from now
+// instead of time.Now, as SetClock does for the package level functions.
+func WithClock(now func() time.Time) GeneratorOption {
+	return func(g *Generator) {
+		g.clock = now
+	}
+}
+
+// now returns the current time of the clock set by SetClock.
+func now() time.Time {
+	if clock := loadConfig().clock; clock != nil {
+		return clock()
+	}
+	return timeNow()
+}
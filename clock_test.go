@@ -0,0 +1,65 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetClock(t *testing.T) {
+	defer Restore(Snapshot())
+	smeared := time.Date(2016, 12, 31, 23, 59, 59, 999500000, time.UTC)
+	SetClock(func() time.Time { return smeared })
+	// Earlier tests left the clock state of Version 7 UUIDs in the present.
+	timeMu.Lock()
+	lastV7time = 0
+	timeMu.Unlock()
+
+	for name, f := range map[string]func() (UUID, error){
+		"NewUUID": NewUUID,
+		"NewV6":   NewV6,
+		"NewV7":   NewV7,
+	} {
+		u, err := f()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := time.Unix(u.Time().UnixTime())
+		want := smeared
+		if u.Version() == 7 {
+			want = smeared.Truncate(time.Millisecond)
+		}
+		if !got.Equal(want) {
+			t.Errorf("%s: got time %v, want %v", name, got.UTC(), want)
+		}
+	}
+
+	u := Must(NewV7())
+	if age, _ := u.Age(); age != smeared.Sub(smeared.Truncate(time.Millisecond)) {
+		t.Errorf("Age = %v, want the age under the clock set", age)
+	}
+	if err := ValidateTimestamp(u, time.Second); err != nil {
+		t.Errorf("ValidateTimestamp: %v", err)
+	}
+
+	SetClock(nil)
+	if u := Must(NewV7()); time.Since(time.Unix(u.Time().UnixTime())) > time.Minute {
+		t.Errorf("SetClock(nil) did not restore time.Now")
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	at := time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC)
+	g, _ := NewGenerator(WithClock(func() time.Time { return at }))
+	u := Must(g.NewV7())
+	if got := time.Unix(u.Time().UnixTime()); !got.Equal(at) {
+		t.Errorf("got time %v, want %v", got.UTC(), at)
+	}
+	b, _ := g.NewV7Batch(2)
+	if Compare(u, b[0]) >= 0 {
+		t.Errorf("NewV7Batch returned %s, not after %s", b[0], u)
+	}
+}
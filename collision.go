@@ -0,0 +1,60 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math"
+	"time"
+)
+
+// Random bits of a Version 4 UUID, and of the Version 7 UUIDs generated in
+// the same millisecond by independent generators (rand_a and rand_b).
+const (
+	v4RandomBits = 122
+	v7RandomBits = 74
+)
+
+// CollisionProbability returns the probability that at least two of n UUIDs
+// with bitsOfEntropy random bits each are equal, such as 122 for Version 4
+// UUIDs or 74 for the Version 7 UUIDs generated in the same millisecond.
+func CollisionProbability(n uint64, bitsOfEntropy int) float64 {
+	if n < 2 {
+		return 0
+	}
+	pairs := float64(n) * float64(n-1) / 2
+	return -math.Expm1(-pairs / math.Ldexp(1, bitsOfEntropy))
+}
+
+// SafeRateFor returns the highest constant rate, in Version 7 UUIDs per
+// millisecond, at which the probability that any two of the Version 7 UUIDs
+// generated during window collide does not exceed p.  As UUIDs of different
+// milliseconds never collide, each millisecond of window is an independent
+// chance of a collision among 74 random bits.  Windows shorter than a
+// millisecond are treated as a millisecond.
+//
+// For example, SafeRateFor(1e-9, 365*24*time.Hour) is the rate a service may
+// sustain for a year with a one in a billion chance of a duplicate.
+func SafeRateFor(p float64, window time.Duration) float64 {
+	switch {
+	case !(p > 0):
+		return 0
+	case p >= 1:
+		return math.Inf(1)
+	}
+	ms := float64(window / time.Millisecond)
+	if ms < 1 {
+		ms = 1
+	}
+	// The probability allowed for each millisecond.
+	q := -math.Expm1(math.Log1p(-p) / ms)
+	return maxUUIDs(q, v7RandomBits)
+}
+
+// maxUUIDs returns the number of UUIDs with bits random bits for which the
+// probability of a collision is p, the inverse of CollisionProbability.
+func maxUUIDs(p float64, bits int) float64 {
+	pairs := -math.Log1p(-p) * math.Ldexp(1, bits)
+	return (1 + math.Sqrt(1+8*pairs)) / 2
+}
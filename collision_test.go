@@ -0,0 +1,60 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCollisionProbability(t *testing.T) {
+	for _, tt := range []struct {
+		n    uint64
+		bits int
+		want float64
+	}{
+		{0, 122, 0},
+		{1, 122, 0},
+		{100, 0, 1},
+		// 2.71e18 Version 4 UUIDs for a 50% chance.
+		{2.71e18, v4RandomBits, 0.5},
+		// 1e9 random UUIDs, p ~= n^2 / 2^123.
+		{1e9, v4RandomBits, 1e18 / math.Ldexp(1, 123)},
+	} {
+		got := CollisionProbability(tt.n, tt.bits)
+		if math.Abs(got-tt.want) > tt.want*0.02 {
+			t.Errorf("CollisionProbability(%d, %d) = %g, want %g", tt.n, tt.bits, got, tt.want)
+		}
+	}
+}
+
+func TestSafeRateFor(t *testing.T) {
+	if got := SafeRateFor(0, time.Hour); got != 0 {
+		t.Errorf("SafeRateFor(0) = %g, want 0", got)
+	}
+	if got := SafeRateFor(1, time.Hour); !math.IsInf(got, 1) {
+		t.Errorf("SafeRateFor(1) = %g, want +Inf", got)
+	}
+	for _, tt := range []struct {
+		p      float64
+		window time.Duration
+	}{
+		{1e-9, 365 * 24 * time.Hour},
+		{1e-6, time.Hour},
+		{0.5, time.Millisecond},
+		{0.01, 0},
+	} {
+		rate := SafeRateFor(tt.p, tt.window)
+		ms := float64(tt.window / time.Millisecond)
+		if ms < 1 {
+			ms = 1
+		}
+		q := CollisionProbability(uint64(rate), v7RandomBits)
+		if got := -math.Expm1(ms * math.Log1p(-q)); math.Abs(got-tt.p) > tt.p*0.01 {
+			t.Errorf("SafeRateFor(%g, %v) = %g, with a probability of %g", tt.p, tt.window, rate, got)
+		}
+	}
+}
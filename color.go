@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "math"
+
+// A Palette is a set of colors returned by ColorFor.
+type Palette int
+
+// Palettes of ColorFor.  All the colors of a palette have the same perceived
+// lightness and differ by hue.
+const (
+	PaletteDefault Palette = iota // medium lightness, for fills with white or black text
+	PaletteDark                   // light colors, legible on dark backgrounds
+	PaletteLight                  // dark colors, legible on light backgrounds
+)
+
+// lightness returns the OKLCH lightness of the colors of p.  Unknown palettes
+// are treated as PaletteDefault.
+func (p Palette) lightness() float64 {
+	switch p {
+	case PaletteDark:
+		return 0.80
+	case PaletteLight:
+		return 0.52
+	}
+	return 0.68
+}
+
+// colorChroma is the OKLCH chroma of the colors of ColorFor, reduced for the
+// hues it is out of the sRGB gamut for.
+const colorChroma = 0.13
+
+// Color returns the color of uuid in PaletteDefault, as ColorFor does.
+func (uuid UUID) Color() (r, g, b uint8) {
+	return uuid.ColorFor(PaletteDefault)
+}
+
+// ColorFor returns a color of p, as 8 bit sRGB components, derived from
+// uuid, for avatars and trace visualizations that show each ID in the same
+// color everywhere.  The color only depends on uuid and p.
+//
+// The hue of the color is derived from a hash of all the bits of uuid, so that
+// UUIDs generated in a row have unrelated colors.  The colors are picked in
+// the OKLCH color space, with the same lightness and chroma, so that colors
+// of different hues are as far apart, and stand out as much, to the eye.  As
+// there are only so many discernible hues, distinct UUIDs often share a
+// color: colors help tell a handful of IDs apart, not identify them.
+func (uuid UUID) ColorFor(p Palette) (r, g, b uint8) {
+	h1, _ := filterHashes(uuid)
+	hue := float64(h1>>11) / (1 << 53) * 2 * math.Pi
+	l := p.lightness()
+	// Reduce the chroma, by bisection, until the color is in the gamut.
+	lo, hi := 0.0, colorChroma
+	if _, ok := oklchToLinear(l, hi, hue); ok {
+		lo = hi
+	}
+	for i := 0; i < 16 && lo < hi; i++ {
+		c := (lo + hi) / 2
+		if _, ok := oklchToLinear(l, c, hue); ok {
+			lo = c
+		} else {
+			hi = c
+		}
+	}
+	rgb, _ := oklchToLinear(l, lo, hue)
+	return srgb8(rgb[0]), srgb8(rgb[1]), srgb8(rgb[2])
+}
+
+// oklchToLinear returns the linear sRGB components of the OKLCH color l, c,
+// h, and whether they are all in the range [0, 1].
+func oklchToLinear(l, c, h float64) (rgb [3]float64, ok bool) {
+	a, b := c*math.Cos(h), c*math.Sin(h)
+	lc := math.Pow(l+0.3963377774*a+0.2158037573*b, 3)
+	mc := math.Pow(l-0.1055613458*a-0.0638541728*b, 3)
+	sc := math.Pow(l-0.0894841775*a-1.2914855480*b, 3)
+	rgb[0] = 4.0767416621*lc - 3.3077115913*mc + 0.2309699292*sc
+	rgb[1] = -1.2684380046*lc + 2.6097574011*mc - 0.3413193965*sc
+	rgb[2] = -0.0041960863*lc - 0.7034186147*mc + 1.7076147010*sc
+	ok = true
+	for _, v := range rgb {
+		if v < 0 || v > 1 {
+			ok = false
+		}
+	}
+	return rgb, ok
+}
+
+// srgb8 returns the linear sRGB component v as an 8 bit sRGB component.
+func srgb8(v float64) uint8 {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		v *= 12.92
+	} else {
+		v = 1.055*math.Pow(v, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(v * 255))
+}
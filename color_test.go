@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math"
+	"testing"
+)
+
+// luminance returns the relative luminance of WCAG of the sRGB color r, g, b.
+func luminance(r, g, b uint8) float64 {
+	lin := func(c uint8) float64 {
+		v := float64(c) / 255
+		if v <= 0.04045 {
+			return v / 12.92
+		}
+		return math.Pow((v+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(r) + 0.7152*lin(g) + 0.0722*lin(b)
+}
+
+func TestColor(t *testing.T) {
+	u := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	r, g, b := u.Color()
+	if r2, g2, b2 := u.ColorFor(PaletteDefault); r != r2 || g != g2 || b != b2 {
+		t.Errorf("Color() = %d, %d, %d, ColorFor(PaletteDefault) = %d, %d, %d", r, g, b, r2, g2, b2)
+	}
+
+	colors := map[[3]uint8]bool{}
+	for i := 0; i < 1000; i++ {
+		u := Must(NewV7())
+		r, g, b := u.Color()
+		colors[[3]uint8{r, g, b}] = true
+		if r2, g2, b2 := u.Color(); r != r2 || g != g2 || b != b2 {
+			t.Fatalf("Color of %s not stable", u)
+		}
+		// Contrast ratios of WCAG with black and white backgrounds.
+		if l := luminance(u.ColorFor(PaletteDark)); (l+0.05)/0.05 < 7 {
+			t.Errorf("PaletteDark color of %s has contrast %.1f with black", u, (l+0.05)/0.05)
+		}
+		if l := luminance(u.ColorFor(PaletteLight)); 1.05/(l+0.05) < 4.5 {
+			t.Errorf("PaletteLight color of %s has contrast %.1f with white", u, 1.05/(l+0.05))
+		}
+	}
+	if len(colors) < 500 {
+		t.Errorf("got %d colors for 1000 UUIDs, want at least 500", len(colors))
+	}
+}
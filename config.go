@@ -0,0 +1,78 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// config is the package configuration, set by the Set, Enable and Disable
+// functions of the package.  A config is never modified once stored in
+// globalConfig; updateConfig stores a modified copy instead, so readers may
+// use it without locking.
+type config struct {
+	rand         io.Reader // random function
+	poolEnabled  bool
+	poolWipe     bool
+	textStyle    Style
+	upper        bool
+	errorHandler ErrorHandler
+	clock        func() time.Time // nil for timeNow
+}
+
+var (
+	configMu     sync.Mutex   // serializes updates of globalConfig
+	globalConfig atomic.Value // of *config
+)
+
+func init() {
+	globalConfig.Store(&config{rand: rand.Reader})
+}
+
+// loadConfig returns the current package configuration.
+func loadConfig() *config {
+	return globalConfig.Load().(*config)
+}
+
+// updateConfig replaces the package configuration with a copy modified by f.
+func updateConfig(f func(c *config)) {
+	defer configMu.Unlock()
+	configMu.Lock()
+	c := *loadConfig()
+	f(&c)
+	globalConfig.Store(&c)
+}
+
+// A Config is a snapshot of the package configuration, set by the Set,
+// Enable and Disable functions of the package, such as SetRand.
+type Config struct {
+	c *config
+}
+
+// Snapshot returns the current package configuration, which can be restored
+// with Restore.  Tests changing the configuration typically use
+//
+//	defer uuid.Restore(uuid.Snapshot())
+//	uuid.SetRand(fixedReader)
+func Snapshot() Config {
+	return Config{loadConfig()}
+}
+
+// Restore restores the package configuration c returned by Snapshot and
+// discards the bytes of the randomness pool.  Restore with the zero Config
+// restores the default configuration.
+func Restore(c Config) {
+	if c.c == nil {
+		c.c = &config{rand: rand.Reader}
+	}
+	configMu.Lock()
+	globalConfig.Store(c.c)
+	configMu.Unlock()
+	pool.Reset()
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	defer Restore(Snapshot())
+
+	SetRand(fakeRand{})
+	EnableRandPool()
+	SetTextStyle(StyleHex)
+	saved := Snapshot()
+
+	SetRand(strings.NewReader(""))
+	DisableRandPool()
+	SetTextStyle(StyleBraced)
+	if _, err := NewRandom(); err == nil {
+		t.Fatalf("NewRandom did not fail with an empty reader")
+	}
+
+	Restore(saved)
+	if c := loadConfig(); !c.poolEnabled || c.textStyle != StyleHex {
+		t.Errorf("Restore did not restore the configuration: %+v", c)
+	}
+	uuid, err := NewRandom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Must(NewRandomFromReader(fakeRand{})); uuid != want {
+		t.Errorf("NewRandom after Restore = %s, want %s", uuid, want)
+	}
+
+	Restore(Config{})
+	if c := loadConfig(); c.poolEnabled || c.textStyle != StyleCanonical {
+		t.Errorf("Restore of the zero Config = %+v, want the default", c)
+	}
+}
+
+func TestConcurrentConfig(t *testing.T) {
+	defer Restore(Snapshot())
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			SetRand(nil)
+			EnableRandPool()
+			DisableRandPool()
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		if _, err := NewRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(done)
+	wg.Wait()
+}
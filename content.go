@@ -0,0 +1,23 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"io"
+)
+
+// NewFromContent returns a content addressed Version 8 UUID derived from the
+// SHA-256 digest of the data read from r until EOF, using the name-based
+// layout of RFC 9562, Appendix B.2.  Blob stores may use it as the key of a
+// blob instead of keeping a separate digest: identical contents always have
+// the same UUID.  An error is returned if reading r fails.
+func NewFromContent(r io.Reader) (UUID, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return Nil, err
+	}
+	return fromSum(h.Sum(nil)), nil
+}
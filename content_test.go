@@ -0,0 +1,36 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNewFromContent(t *testing.T) {
+	// The SHA-256 digest of "" is e3b0c442 98fc1c14 9afbf4c8 996fb924 ...
+	u, err := NewFromContent(strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := MustParse("e3b0c442-98fc-8c14-9afb-f4c8996fb924"); u != want {
+		t.Errorf("NewFromContent(\"\") = %s, want %s", u, want)
+	}
+	data := strings.Repeat("blob contents ", 10000)
+	u1, _ := NewFromContent(strings.NewReader(data))
+	u2, _ := NewFromContent(iotest.OneByteReader(strings.NewReader(data)))
+	if u1 != u2 {
+		t.Errorf("NewFromContent depends on the read sizes: %s != %s", u1, u2)
+	}
+	if u3, _ := NewFromContent(strings.NewReader(data + ".")); u3 == u1 {
+		t.Errorf("NewFromContent returned %s for different contents", u3)
+	}
+	if _, err := NewFromContent(iotest.ErrReader(io.ErrUnexpectedEOF)); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("got error %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
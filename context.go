@@ -0,0 +1,27 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "context"
+
+type generatorKey struct{}
+
+// WithGenerator returns a copy of ctx carrying gen, a function generating
+// UUIDs such as NewV7, the NewV7 method of a Generator or a deterministic
+// function in tests, to be used by NewFromContext.  This allows choosing the
+// generator per request or per tenant without changing the package
+// configuration.
+func WithGenerator(ctx context.Context, gen func() (UUID, error)) context.Context {
+	return context.WithValue(ctx, generatorKey{}, gen)
+}
+
+// NewFromContext returns a UUID of the function set with WithGenerator in
+// ctx, or, if there is none, a Random (Version 4) UUID of NewRandom.
+func NewFromContext(ctx context.Context) (UUID, error) {
+	if gen, ok := ctx.Value(generatorKey{}).(func() (UUID, error)); ok && gen != nil {
+		return gen()
+	}
+	return NewRandom()
+}
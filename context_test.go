@@ -0,0 +1,35 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewFromContext(t *testing.T) {
+	ctx := context.Background()
+	uuid, err := NewFromContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := uuid.Version(); v != 4 {
+		t.Errorf("NewFromContext without generator returned version %d, want 4", v)
+	}
+
+	g, _ := NewGenerator()
+	ctx = WithGenerator(ctx, g.NewV7)
+	if uuid, _ = NewFromContext(ctx); uuid.Version() != 7 {
+		t.Errorf("NewFromContext returned %s, want a Version 7 UUID", uuid)
+	}
+
+	ctx = WithGenerator(ctx, func() (UUID, error) { return NameSpaceDNS, nil })
+	if uuid, _ = NewFromContext(ctx); uuid != NameSpaceDNS {
+		t.Errorf("NewFromContext returned %s, want %s", uuid, NameSpaceDNS)
+	}
+	if uuid, _ = NewFromContext(WithGenerator(ctx, nil)); uuid.Version() != 4 {
+		t.Errorf("NewFromContext with nil generator returned %s", uuid)
+	}
+}
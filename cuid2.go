@@ -0,0 +1,100 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/bits"
+)
+
+// ErrInvalidCUID2 is returned by FromCUID2 for strings that are not cuid2s.
+var ErrInvalidCUID2 = errors.New("invalid cuid2")
+
+// maxLosslessCUID2 is the length of the longest cuid2 FromCUID2 stores
+// losslessly: 36^22 < 2^114.
+const maxLosslessCUID2 = 22
+
+// FromCUID2 returns a Version 8 UUID representing the cuid2 s, a string of 2
+// to 32 lowercase letters and digits starting with a letter, for ingesting
+// identifiers of JavaScript systems.  The UUID of a cuid2 is always the same.
+//
+// cuid2s of up to 22 characters are stored losslessly and are returned by
+// UUID.CUID2.  Longer cuid2s, including those of the default length of 24,
+// hold more bits than a UUID and are represented by a SHA-256 hash, which
+// cannot be reversed.  The UUIDs are not ordered in any meaningful way, like
+// cuid2s themselves.
+//
+// The 122 bits that are neither version nor variant hold a flag bit, set for
+// lossless UUIDs, followed by the length of s in 5 bits and the 116 bit
+// base 36 value of s, or by 121 bits of the hash of s.
+func FromCUID2(s string) (UUID, error) {
+	if len(s) < 2 || len(s) > 32 || s[0] < 'a' || s[0] > 'z' {
+		return Nil, ErrInvalidCUID2
+	}
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		d := base36Digit(s[i])
+		if d >= 36 {
+			return Nil, ErrInvalidCUID2
+		}
+		if i < maxLosslessCUID2 {
+			var c uint64
+			h, l := bits.Mul64(lo, 36)
+			hi = hi*36 + h
+			lo, c = bits.Add64(l, d, 0)
+			hi += c
+		}
+	}
+	if len(s) <= maxLosslessCUID2 {
+		hi |= 1<<57 | uint64(len(s))<<52 // bits 121 and 116-120 of the payload
+	} else {
+		var h UUID
+		sum := sha256.Sum256([]byte(s))
+		copy(h[:], sum[:])
+		hi, lo = uint128(h)
+		hi, lo = shr128(hi, lo, 7)
+	}
+	return fromPayload(hi, lo, 8), nil
+}
+
+// CUID2 returns the cuid2 stored in uuid by FromCUID2.  It returns false if
+// uuid is not a Version 8 UUID holding a cuid2 of up to 22 characters; as
+// other Version 8 layouts may happen to match, a true result is a best
+// effort guess for UUIDs of unknown origin.
+func (uuid UUID) CUID2() (string, bool) {
+	if uuid.Version() != 8 || uuid.Variant() != RFC4122 {
+		return "", false
+	}
+	hi, lo := payload(uuid)
+	n := int(hi >> 52 & 0x1f)
+	if hi>>57 != 1 || n < 2 || n > maxLosslessCUID2 {
+		return "", false
+	}
+	hi &= 1<<52 - 1
+	var buf [maxLosslessCUID2]byte
+	for i := n - 1; i >= 0; i-- {
+		var r uint64
+		hi, r = bits.Div64(0, hi, 36)
+		lo, r = bits.Div64(r, lo, 36)
+		buf[i] = "0123456789abcdefghijklmnopqrstuvwxyz"[r]
+	}
+	if hi != 0 || lo != 0 || buf[0] < 'a' {
+		return "", false
+	}
+	return string(buf[:n]), true
+}
+
+// base36Digit returns the value of the lowercase base 36 digit c, or 36 or
+// more if c is not one.
+func base36Digit(c byte) uint64 {
+	switch {
+	case c >= '0' && c <= '9':
+		return uint64(c - '0')
+	case c >= 'a' && c <= 'z':
+		return uint64(c-'a') + 10
+	}
+	return 36
+}
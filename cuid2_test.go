@@ -0,0 +1,54 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestCUID2(t *testing.T) {
+	for _, s := range []string{
+		"ab",
+		"a0",
+		"tz4a98xxat96iws9zmbrgj",
+		"zzzzzzzzzzzzzzzzzzzzzz",
+		"a000000000000000000000",
+	} {
+		uuid, err := FromCUID2(s)
+		if err != nil {
+			t.Fatalf("FromCUID2(%q): %v", s, err)
+		}
+		if v := uuid.Version(); v != 8 || uuid.Variant() != RFC4122 {
+			t.Errorf("FromCUID2(%q) = %s, not a Version 8 UUID", s, uuid)
+		}
+		if got, ok := uuid.CUID2(); !ok || got != s {
+			t.Errorf("CUID2() = %q, %v, want %q, true", got, ok, s)
+		}
+	}
+
+	long := "tz4a98xxat96iws9zmbrgj3a"
+	u1, err := FromCUID2(long)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u2, _ := FromCUID2(long); u1 != u2 {
+		t.Errorf("FromCUID2 is not deterministic: %s != %s", u1, u2)
+	}
+	if u2, _ := FromCUID2(long[:23] + "b"); u1 == u2 {
+		t.Errorf("FromCUID2 of different cuid2s returned %s", u1)
+	}
+	if _, ok := u1.CUID2(); ok {
+		t.Errorf("CUID2 of a hashed cuid2 succeeded")
+	}
+
+	for _, s := range []string{"", "a", "0abc", "Abc", "ab-c", "a234567890123456789012345678901234"} {
+		if _, err := FromCUID2(s); err != ErrInvalidCUID2 {
+			t.Errorf("FromCUID2(%q) got error %v, want %v", s, err, ErrInvalidCUID2)
+		}
+	}
+	for _, u := range []UUID{Nil, Max, NameSpaceDNS, Must(NewV7())} {
+		if _, ok := u.CUID2(); ok {
+			t.Errorf("CUID2 of %s succeeded", u)
+		}
+	}
+}
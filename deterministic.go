@@ -0,0 +1,36 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// NewDeterministic returns a Version 8 UUID derived from the HMAC-SHA256 of
+// counter keyed with seed.  The same seed and counter always return the same
+// UUID, so data migrations that number their source rows can be re-run
+// idempotently, while the UUIDs cannot be predicted without the seed.  The
+// seed should be at least 32 random bytes kept for the lifetime of the
+// migrated data.
+func NewDeterministic(seed []byte, counter uint64) UUID {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	h := hmac.New(sha256.New, seed)
+	h.Write(msg[:]) //nolint:errcheck
+	return fromSum(h.Sum(nil))
+}
+
+// fromSum returns a Version 8 UUID of the first 16 bytes of sum, the digest
+// of a hash function, with the version and variant bits set as in the
+// name-based example of RFC 9562, Appendix B.2.
+func fromSum(sum []byte) UUID {
+	var uuid UUID
+	copy(uuid[:], sum)
+	uuid[6] = (uuid[6] & 0x0f) | 0x80 // Version 8
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // RFC 9562 variant
+	return uuid
+}
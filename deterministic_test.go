@@ -0,0 +1,29 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestNewDeterministic(t *testing.T) {
+	seed := []byte("0123456789abcdef0123456789abcdef")
+	u := NewDeterministic(seed, 42)
+	if u.Version() != 8 || u.Variant() != RFC4122 {
+		t.Errorf("NewDeterministic returned %s (version %s, variant %s)", u, u.Version(), u.Variant())
+	}
+	if again := NewDeterministic(seed, 42); again != u {
+		t.Errorf("NewDeterministic is not deterministic: %s != %s", again, u)
+	}
+	seen := map[UUID]bool{u: true}
+	for _, other := range []UUID{
+		NewDeterministic(seed, 43),
+		NewDeterministic(seed, 0),
+		NewDeterministic([]byte("another seed"), 42),
+	} {
+		if seen[other] {
+			t.Errorf("NewDeterministic returned %s twice", other)
+		}
+		seen[other] = true
+	}
+}
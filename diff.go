@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strings"
+	"time"
+)
+
+// A DiffReport describes how two UUIDs differ, see Diff.
+type DiffReport struct {
+	// SharedPrefixBits is the number of leading bits the UUIDs have in
+	// common, 128 if they are equal.
+	SharedPrefixBits int
+
+	// TimeComparable is true if both UUIDs are version 1, 6 or 7 UUIDs, in
+	// which case TimeDelta is the time of the second UUID minus the time of
+	// the first.
+	TimeComparable bool
+	TimeDelta      time.Duration
+
+	// Fields are the names of the fields that differ, as named by RFC 9562
+	// for the version of the first UUID, plus "version" and "variant" if
+	// those differ.
+	Fields []string
+}
+
+// String returns a one line summary of r.
+func (r DiffReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "shared prefix: %d bits", r.SharedPrefixBits)
+	if r.TimeComparable {
+		fmt.Fprintf(&b, ", time delta: %v", r.TimeDelta)
+	}
+	if len(r.Fields) > 0 {
+		fmt.Fprintf(&b, ", differing fields: %s", strings.Join(r.Fields, ", "))
+	}
+	return b.String()
+}
+
+// A field is a named range of bits [start, end) of a UUID.
+type field struct {
+	name       string
+	start, end int
+}
+
+// fields returns the fields of the layout of version v, excluding the
+// version and variant bits.
+func fields(v Version) []field {
+	names := map[Version][5]string{
+		1: {"time_low", "time_mid", "time_high", "clock_seq", "node"},
+		2: {"time_low", "time_mid", "time_high", "clock_seq", "node"},
+		6: {"time_high", "time_mid", "time_low", "clock_seq", "node"},
+		3: {"md5_high", "md5_mid", "md5_low"},
+		4: {"random_a", "random_b", "random_c"},
+		5: {"sha1_high", "sha1_mid", "sha1_low"},
+		7: {"unix_ts_ms", "rand_a", "rand_b"},
+	}
+	n, ok := names[v]
+	if !ok {
+		n = [5]string{"custom_a", "custom_b", "custom_c"}
+	}
+	if n[3] == "" {
+		return []field{{n[0], 0, 48}, {n[1], 52, 64}, {n[2], 66, 128}}
+	}
+	return []field{{n[0], 0, 32}, {n[1], 32, 48}, {n[2], 52, 64}, {n[3], 66, 80}, {n[4], 80, 128}}
+}
+
+// differ reports whether a and b differ in the bits of f.
+func (f field) differ(a, b UUID) bool {
+	for i := f.start; i < f.end; i++ {
+		mask := byte(0x80) >> (i % 8)
+		if a[i/8]&mask != b[i/8]&mask {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff reports how b differs from a, helping to explain, for example, why two
+// UUIDs land in the same partition of a partitioner using their leading bits.
+func Diff(a, b UUID) DiffReport {
+	var r DiffReport
+	hi := binary.BigEndian.Uint64(a[:8]) ^ binary.BigEndian.Uint64(b[:8])
+	lo := binary.BigEndian.Uint64(a[8:]) ^ binary.BigEndian.Uint64(b[8:])
+	if hi != 0 {
+		r.SharedPrefixBits = bits.LeadingZeros64(hi)
+	} else {
+		r.SharedPrefixBits = 64 + bits.LeadingZeros64(lo)
+	}
+
+	ta, oka := a.timestamp()
+	tb, okb := b.timestamp()
+	if oka && okb {
+		r.TimeComparable = true
+		r.TimeDelta = tb.Sub(ta)
+	}
+
+	if a.Version() != b.Version() {
+		r.Fields = append(r.Fields, "version")
+	}
+	if a.Variant() != b.Variant() {
+		r.Fields = append(r.Fields, "variant")
+	}
+	for _, f := range fields(a.Version()) {
+		if f.differ(a, b) {
+			r.Fields = append(r.Fields, f.name)
+		}
+	}
+	return r
+}
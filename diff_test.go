@@ -0,0 +1,69 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	for _, tt := range []struct {
+		a, b   string
+		prefix int
+		delta  time.Duration
+		fields []string
+	}{
+		{
+			"018bd12c-58b0-7683-8a5b-8752d0e86651",
+			"018bd12c-58b0-7683-8a5b-8752d0e86651",
+			128, 0, nil,
+		},
+		{
+			"018bd12c-58b0-7683-8a5b-8752d0e86651",
+			"018bd12c-58b1-7683-8a5b-8752d0e86650",
+			47, time.Millisecond, []string{"unix_ts_ms", "rand_b"},
+		},
+		{
+			"018bd12c-58b0-7683-8a5b-8752d0e86651",
+			"018bd12c-58b0-7684-ca5b-8752d0e86651",
+			61, 0, []string{"variant", "rand_a"},
+		},
+		{
+			"01ee836c-e7c9-619d-929a-525400475911",
+			"01ee836c-e7c9-619d-929a-525400475912",
+			126, 0, []string{"node"},
+		},
+		{
+			"f47ac10b-58cc-4372-8567-0e02b2c3d479",
+			"f47ac10b-58cc-5372-8567-0e02b2c3d479",
+			51, 0, []string{"version"},
+		},
+	} {
+		r := Diff(MustParse(tt.a), MustParse(tt.b))
+		if r.SharedPrefixBits != tt.prefix {
+			t.Errorf("Diff(%s, %s).SharedPrefixBits = %d, want %d", tt.a, tt.b, r.SharedPrefixBits, tt.prefix)
+		}
+		if r.TimeDelta != tt.delta {
+			t.Errorf("Diff(%s, %s).TimeDelta = %v, want %v", tt.a, tt.b, r.TimeDelta, tt.delta)
+		}
+		if !reflect.DeepEqual(r.Fields, tt.fields) {
+			t.Errorf("Diff(%s, %s).Fields = %q, want %q", tt.a, tt.b, r.Fields, tt.fields)
+		}
+	}
+}
+
+func TestDiffReportString(t *testing.T) {
+	r := Diff(MustParse("018bd12c-58b0-7683-8a5b-8752d0e86651"), MustParse("018bd12c-58b1-7683-8a5b-8752d0e86651"))
+	const want = "shared prefix: 47 bits, time delta: 1ms, differing fields: unix_ts_ms"
+	if s := r.String(); s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	r = Diff(New(), New())
+	if r.TimeComparable {
+		t.Errorf("version 4 UUIDs are time comparable")
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "time"
+
+// expiryTag marks the Version 8 UUIDs of NewV8WithExpiry.
+const expiryTag = 0xe4a
+
+// NewV8WithExpiry returns a Version 8 UUID holding the time ttl from now,
+// after which Expired reports it as expired, so that ephemeral tokens such as
+// upload sessions and invitations can be checked for expiry without a lookup.
+// The remaining bits are random, read as NewRandom does.  The layout is
+//
+//	expiry_ms  48 bits, Unix time in milliseconds of the expiry
+//	ver         4 bits, 8
+//	tag        12 bits, 0xe4a
+//	var         2 bits, 0b10
+//	rand       62 bits
+//
+// The UUIDs sort by expiry, so expired tokens can be purged with a range scan.
+// The expiry is not authenticated: anyone may craft a UUID with a later
+// expiry, so the expiry only spares a lookup of tokens already expired.
+func NewV8WithExpiry(ttl time.Duration) (UUID, error) {
+	uuid, err := NewRandom()
+	if err != nil {
+		return Nil, err
+	}
+	putV7Time(uuid[:], TimeToUnixMilli(now().Add(ttl)), expiryTag)
+	uuid[6] = uuid[6]&0x0f | 0x80 // Version 8
+	return uuid, nil
+}
+
+// Expiry returns the expiry time of uuid, a UUID returned by NewV8WithExpiry,
+// and true, or the zero time and false if uuid is not a Version 8 UUID with
+// the 12 bit tag of NewV8WithExpiry.  A true result only makes it probable
+// that uuid was returned by NewV8WithExpiry: one in 4096 other Version 8
+// UUIDs, such as random ones, carries the tag by chance.
+func Expiry(uuid UUID) (time.Time, bool) {
+	if uuid.Version() != 8 || uuid.Variant() != RFC4122 || GetBits(uuid, 52, 12) != expiryTag {
+		return time.Time{}, false
+	}
+	return UnixMilliToTime(int64(GetBits(uuid, 0, 48))), true
+}
+
+// Expired reports whether uuid, a UUID returned by NewV8WithExpiry, has
+// expired.  UUIDs for which Expiry returns false are reported as expired.
+func Expired(uuid UUID) bool {
+	t, ok := Expiry(uuid)
+	return !ok || !now().Before(t)
+}
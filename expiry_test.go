@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewV8WithExpiry(t *testing.T) {
+	at := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return at }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	u, err := NewV8WithExpiry(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Version() != 8 || u.Variant() != RFC4122 {
+		t.Errorf("NewV8WithExpiry returned %s of version %s and variant %s", u, u.Version(), u.Variant())
+	}
+	if exp, ok := Expiry(u); !ok || !exp.Equal(at.Add(time.Hour)) {
+		t.Errorf("Expiry() = %v, %v, want %v", exp, ok, at.Add(time.Hour))
+	}
+	if Expired(u) {
+		t.Errorf("UUID expired before its expiry")
+	}
+	if short := Must(NewV8WithExpiry(time.Minute)); Compare(short, u) >= 0 {
+		t.Errorf("UUIDs do not sort by expiry")
+	}
+	at = at.Add(time.Hour - time.Millisecond)
+	if Expired(u) {
+		t.Errorf("UUID expired a millisecond before its expiry")
+	}
+	at = at.Add(time.Millisecond)
+	if !Expired(u) {
+		t.Errorf("UUID not expired at its expiry")
+	}
+
+	for _, other := range []UUID{Nil, testUUID, Must(NewV7()), NewDeterministic([]byte("seed"), 1)} {
+		if _, ok := Expiry(other); ok {
+			t.Errorf("Expiry(%s) succeeded", other)
+		}
+		if !Expired(other) {
+			t.Errorf("Expired(%s) = false, want true", other)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+)
+
+// A UniquenessFilter is a Bloom filter for deduplicating large streams of
+// UUIDs in bounded memory.  SeenOrAdd never reports a new UUID as unseen
+// twice, but may report a UUID that was never added as seen with the false
+// positive rate chosen with NewUniquenessFilter, as long as no more UUIDs than
+// planned for are added.
+//
+// A UniquenessFilter is safe for concurrent use by multiple goroutines.
+type UniquenessFilter struct {
+	k uint64 // number of hash functions
+	m uint64 // number of bits
+
+	mu    sync.Mutex
+	bits  []uint64 // protected by mu
+	count uint64   // protected by mu
+}
+
+// NewUniquenessFilter returns a UniquenessFilter sized for n UUIDs with a
+// false positive rate of p.  The filter uses about -n*ln(p)/ln(2)^2 bits,
+// e.g. 1.2 MB for a million UUIDs at p = 0.0001.  If p is not between 0 and 1
+// a rate of 0.01 is used.
+func NewUniquenessFilter(n uint64, p float64) *UniquenessFilter {
+	if n == 0 {
+		n = 1
+	}
+	if !(p > 0 && p < 1) {
+		p = 0.01
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	m = (m + 63) &^ 63
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &UniquenessFilter{
+		k:    k,
+		m:    m,
+		bits: make([]uint64, m/64),
+	}
+}
+
+// SeenOrAdd reports whether uuid has probably been added to f before and adds
+// it if it has not.
+func (f *UniquenessFilter) SeenOrAdd(uuid UUID) bool {
+	h1, h2 := filterHashes(uuid)
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	seen := true
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		w, mask := bit/64, uint64(1)<<(bit%64)
+		if f.bits[w]&mask == 0 {
+			seen = false
+			f.bits[w] |= mask
+		}
+	}
+	if !seen {
+		f.count++
+	}
+	return seen
+}
+
+// Count returns the number of UUIDs added to f, that is, the number of calls
+// to SeenOrAdd that returned false.
+func (f *UniquenessFilter) Count() uint64 {
+	defer f.mu.Unlock()
+	f.mu.Lock()
+	return f.count
+}
+
+// filterHashes returns the two hashes of uuid from which the bit positions of
+// the filter are derived (Kirsch and Mitzenmacher).  The halves of uuid are
+// mixed as the leading bits of time based UUIDs are far from uniform.
+func filterHashes(uuid UUID) (h1, h2 uint64) {
+	hi := binary.BigEndian.Uint64(uuid[:8])
+	lo := binary.BigEndian.Uint64(uuid[8:])
+	h1 = mix64(hi ^ mix64(lo))
+	h2 = mix64(lo^0x9e3779b97f4a7c15^h1) | 1
+	return h1, h2
+}
+
+// mix64 is the finalizer of SplitMix64.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
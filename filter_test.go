@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestUniquenessFilter(t *testing.T) {
+	const n = 10000
+	f := NewUniquenessFilter(2*n, 0.001)
+	ids := make([]UUID, n)
+	falsePositives := 0
+	for i := range ids {
+		ids[i] = Must(NewV7())
+		if f.SeenOrAdd(ids[i]) {
+			falsePositives++
+		}
+	}
+	for i, uuid := range ids {
+		if !f.SeenOrAdd(uuid) {
+			t.Fatalf("#%d: %s not seen", i, uuid)
+		}
+	}
+	for i := 0; i < n; i++ {
+		if f.SeenOrAdd(New()) {
+			falsePositives++
+		}
+	}
+	// Expect less than 20 (2n * 0.001) as the filter fills up to 2n.
+	if falsePositives > 50 {
+		t.Errorf("%d false positives for %d UUIDs", falsePositives, n)
+	}
+	if c := f.Count(); c != 2*n-uint64(falsePositives) {
+		t.Errorf("Count() = %d, want %d", c, 2*n-falsePositives)
+	}
+}
+
+func TestUniquenessFilterDefaults(t *testing.T) {
+	f := NewUniquenessFilter(0, 2)
+	if f.SeenOrAdd(Nil) {
+		t.Errorf("Nil seen in empty filter")
+	}
+	if !f.SeenOrAdd(Nil) {
+		t.Errorf("Nil not seen after adding it")
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// Set implements flag.Value so a UUID can be used as a command-line flag:
+//
+//	var id uuid.UUID
+//	flag.Var(&id, "id", "the `uuid` of the record")
+//
+// Set accepts the forms accepted by ParseFormat with FormatAny.
+func (uuid *UUID) Set(s string) error {
+	id, err := ParseFormat(s, FormatAny)
+	if err != nil {
+		return err
+	}
+	*uuid = id
+	return nil
+}
+
+// Get implements flag.Getter.  It returns the UUID as a UUID.
+func (uuid *UUID) Get() interface{} {
+	return *uuid
+}
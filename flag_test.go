@@ -0,0 +1,38 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	var id UUID
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&id, "id", "")
+	if err := fs.Parse([]string{"-id", NameSpaceDNS.URN()}); err != nil {
+		t.Fatal(err)
+	}
+	if id != NameSpaceDNS {
+		t.Errorf("got %s, want %s", id, NameSpaceDNS)
+	}
+	if got := fs.Lookup("id").Value.(flag.Getter).Get(); got != NameSpaceDNS {
+		t.Errorf("Get() = %v, want %s", got, NameSpaceDNS)
+	}
+	if got := fs.Lookup("id").Value.String(); got != NameSpaceDNS.String() {
+		t.Errorf("String() = %q, want %q", got, NameSpaceDNS.String())
+	}
+	for _, bad := range []string{"", "not-a-uuid", "[" + NameSpaceDNS.String() + "]"} {
+		if err := fs.Parse([]string{"-id", bad}); err == nil {
+			t.Errorf("Parse(-id %q) succeeded", bad)
+		}
+	}
+	if id != NameSpaceDNS {
+		t.Errorf("failed Set changed the flag to %s", id)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/base64"
+
+// base58 is the alphabet of the base58 encoding of Bitcoin, used for short
+// UUIDs with EncodeAlphabet.
+const base58 = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// FlexUUID is a UUID whose UnmarshalText, and thus decoding by encoding/json
+// and other decoders using encoding.TextUnmarshaler, accepts all the forms
+// accepted by ParseFlex, for struct fields ingesting IDs from partners that
+// encode UUIDs differently.  MarshalText emits the same text as UUID does.
+// UUID itself only accepts the forms of Parse.  FlexBase58UUID is the same
+// for partners sending base58 rather than base64 UUIDs.
+type FlexUUID UUID
+
+// String returns f in the standard form, as UUID.String does.
+func (f FlexUUID) String() string {
+	return UUID(f).String()
+}
+
+// MarshalText implements encoding.TextMarshaler as UUID.MarshalText does.
+func (f FlexUUID) MarshalText() ([]byte, error) {
+	return UUID(f).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding data with
+// ParseFlex.
+func (f *FlexUUID) UnmarshalText(data []byte) error {
+	id, err := ParseFlex(string(data))
+	if err != nil {
+		return err
+	}
+	*f = FlexUUID(id)
+	return nil
+}
+
+// FlexBase58UUID is like FlexUUID, but decodes data with ParseFlexBase58.
+type FlexBase58UUID UUID
+
+// String returns f in the standard form, as UUID.String does.
+func (f FlexBase58UUID) String() string {
+	return UUID(f).String()
+}
+
+// MarshalText implements encoding.TextMarshaler as UUID.MarshalText does.
+func (f FlexBase58UUID) MarshalText() ([]byte, error) {
+	return UUID(f).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding data with
+// ParseFlexBase58.
+func (f *FlexBase58UUID) UnmarshalText(data []byte) error {
+	id, err := ParseFlexBase58(string(data))
+	if err != nil {
+		return err
+	}
+	*f = FlexBase58UUID(id)
+	return nil
+}
+
+// ParseFlex decodes s as any of the common text encodings of a UUID, detected
+// from the length of s:
+//
+//	36, 38, 45 or 32 bytes  the forms accepted by Parse
+//	26 bytes                Crockford's base32, as ULIDs and EncodeSortable
+//	24 bytes                base64 with padding, standard or URL alphabet
+//	22 bytes                base64 without padding, standard or URL alphabet
+//
+// Base58 UUIDs also have 22 bytes, and many of them are valid base64: as
+// the two cannot be told apart, ParseFlex decodes 22 bytes as base64 only,
+// and ParseFlexBase58 as base58 only.
+func ParseFlex(s string) (UUID, error) {
+	return parseFlex(s, false)
+}
+
+// ParseFlexBase58 is like ParseFlex, but decodes a 22 byte s as base58, in
+// the alphabet of Bitcoin padded to 22 digits as by EncodeAlphabet, rather
+// than as base64.
+func ParseFlexBase58(s string) (UUID, error) {
+	return parseFlex(s, true)
+}
+
+// parseFlex implements ParseFlex, and ParseFlexBase58 if b58 is true.
+func parseFlex(s string, b58 bool) (UUID, error) {
+	switch len(s) {
+	case 36, 36 + 2, 36 + 9, 32:
+		return Parse(s)
+	case 26:
+		return ParseSortable(s)
+	case 24:
+		return decodeBase64(s, base64.StdEncoding, base64.URLEncoding)
+	case 22:
+		if b58 {
+			return DecodeAlphabet(s, base58)
+		}
+		return decodeBase64(s, base64.RawStdEncoding, base64.RawURLEncoding)
+	}
+	return Nil, invalidLengthError{len(s)}
+}
+
+// decodeBase64 decodes s, the base64 encoding of a UUID, with the strict form
+// of either std or url.
+func decodeBase64(s string, std, url *base64.Encoding) (UUID, error) {
+	var uuid UUID
+	for _, enc := range []*base64.Encoding{std, url} {
+		if n, err := enc.Strict().Decode(uuid[:], []byte(s)); err == nil && n == 16 {
+			return uuid, nil
+		}
+	}
+	return Nil, ErrInvalidUUIDFormat
+}
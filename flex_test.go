@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func TestParseFlex(t *testing.T) {
+	u := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	for _, s := range []string{
+		u.String(),
+		u.URN(),
+		u.FormatBraced(),
+		u.FormatSAP(),
+		u.EncodeSortable(),
+		base64.StdEncoding.EncodeToString(u[:]),
+		base64.URLEncoding.EncodeToString(u[:]),
+		base64.RawStdEncoding.EncodeToString(u[:]),
+		base64.RawURLEncoding.EncodeToString(u[:]),
+	} {
+		got, err := ParseFlex(s)
+		if err != nil {
+			t.Errorf("ParseFlex(%q): %v", s, err)
+		} else if got != u {
+			t.Errorf("ParseFlex(%q) = %s, want %s", s, got, u)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"f47ac10b58cc4372a5670e02b2c3d47",
+		"!!!!!!!!!!!!!!!!!!!!!!",
+		"9ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		"zzzzzzzzzzzzzzzzzzzzzz",
+	} {
+		if got, err := ParseFlex(s); err == nil {
+			t.Errorf("ParseFlex(%q) = %s, want an error", s, got)
+		}
+	}
+}
+
+func TestParseFlexBase58(t *testing.T) {
+	for _, u := range []UUID{
+		MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+		// Its base58 encoding, BW4Z4vda7Xpi5Cdu5uQjow, is valid base64.
+		MustParse("550a253d-f506-457f-ba90-677e612bb7e2"),
+	} {
+		s := EncodeAlphabet(u, base58)
+		if got, err := ParseFlexBase58(s); err != nil || got != u {
+			t.Errorf("ParseFlexBase58(%q) = %s, %v, want %s", s, got, err, u)
+		}
+		if got, err := ParseFlexBase58(u.String()); err != nil || got != u {
+			t.Errorf("ParseFlexBase58(%q) = %s, %v, want %s", u.String(), got, err, u)
+		}
+		if got, err := ParseFlex(s); err == nil && got == u {
+			t.Errorf("ParseFlex(%q) decoded base58", s)
+		}
+	}
+	const b58 = "BW4Z4vda7Xpi5Cdu5uQjow"
+	b64, err := ParseFlex(b58)
+	if err != nil {
+		t.Fatalf("ParseFlex(%q): %v", b58, err)
+	}
+	if b64 == MustParse("550a253d-f506-457f-ba90-677e612bb7e2") {
+		t.Errorf("ParseFlex(%q) decoded base58", b58)
+	}
+	if got, err := ParseFlexBase58("zzzzzzzzzzzzzzzzzzzzzz"); err == nil {
+		t.Errorf("ParseFlexBase58 of an overflowing number = %s, want an error", got)
+	}
+}
+
+func TestFlexUUIDJSON(t *testing.T) {
+	u := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	var v struct {
+		ID  FlexUUID
+		B58 FlexBase58UUID
+	}
+	data := `{"ID":"` + base64.RawURLEncoding.EncodeToString(u[:]) + `","B58":"` + EncodeAlphabet(u, base58) + `"}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	if UUID(v.ID) != u || UUID(v.B58) != u {
+		t.Errorf("got %s and %s, want %s", v.ID, v.B58, u)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"ID":"` + u.String() + `","B58":"` + u.String() + `"}`; string(out) != want {
+		t.Errorf("got %s, want %s", out, want)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// ErrFormatNotAllowed is returned by ParseFormat when s is a UUID in a form
+// that is not included in the requested Format.
+var ErrFormatNotAllowed = errors.New("UUID format not allowed")
+
+// A Format is a set of the string forms of a UUID accepted by ParseFormat.
+// Formats are combined with the | operator.  The zero Format is treated as
+// FormatAny.
+type Format uint
+
+// Forms of a UUID.
+const (
+	FormatCanonical Format = 1 << iota // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	FormatURN                          // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	FormatBraced                       // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+	FormatHex                          // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	FormatParens                       // (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+
+	// FormatAny accepts all the forms accepted by Parse.
+	FormatAny = FormatCanonical | FormatURN | FormatBraced | FormatHex | FormatParens
+)
+
+// ParseFormat is like Parse but only accepts the forms included in f, so
+// that, for example, ParseFormat(s, FormatCanonical) only accepts the
+// standard form of RFC 9562, and
+//
+//	ParseFormat(s, FormatCanonical|FormatBraced|FormatParens)
+//
+// accepts the standard form with optional braces or parentheses.  Unlike
+// Parse, ParseFormat requires matching braces or parentheses for the
+// FormatBraced and FormatParens forms.
+func ParseFormat(s string, f Format) (UUID, error) {
+	if f == 0 {
+		f = FormatAny
+	}
+	var form Format
+	switch len(s) {
+	case 36:
+		form = FormatCanonical
+	case 36 + 9:
+		form = FormatURN
+	case 36 + 2:
+		switch {
+		case s[0] == '{' && s[37] == '}':
+			form = FormatBraced
+		case s[0] == '(' && s[37] == ')':
+			form = FormatParens
+		default:
+			return Nil, ErrInvalidBracketedFormat
+		}
+	case 32:
+		form = FormatHex
+	default:
+		return Nil, invalidLengthError{len(s)}
+	}
+	if f&form == 0 {
+		return Nil, ErrFormatNotAllowed
+	}
+	return Parse(s)
+}
+
+// FormatBraced returns uuid in the registry format of Windows,
+// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}.
+func (uuid UUID) FormatBraced() string {
+	var buf [36 + 2]byte
+	encodeStyle(buf[:], uuid, StyleBraced)
+	return string(buf[:])
+}
+
+// ShortString returns the first n hex digits of uuid, with the hyphens between
+// them, followed by an ellipsis, such as 123e4567… for n = 8, for logs and
+// dashboards showing IDs in little space.  The result is a prefix of String,
+// so that it can be searched for in full logs.  ShortString returns String
+// if n is 32 or more and an ellipsis alone if n is 0 or less.
+//
+// Distinct UUIDs may have the same short string.  Among k Version 4 UUIDs,
+// two short strings are equal with probability CollisionProbability(k, 4*n),
+// so that 8 digits are ambiguous with a probability of about 1% among 10,000
+// UUIDs.  The first 12 digits of Version 6 and 7 UUIDs are the high bits of
+// their time, shared by all UUIDs generated in the same period: short strings
+// of time ordered UUIDs need more digits to tell them apart, and should not
+// be used to identify them in tooling.
+func (uuid UUID) ShortString(n int) string {
+	if n >= 32 {
+		return uuid.String()
+	}
+	if n <= 0 {
+		return "…"
+	}
+	end := n
+	for _, h := range [...]int{8, 12, 16, 20} {
+		if n > h {
+			end++
+		}
+	}
+	return uuid.String()[:end] + "…"
+}
+
+// AppendFormat appends uuid in style s to dst and returns the extended
+// buffer.  Unlike String, AppendFormat does not allocate if dst has room for
+// the UUID, so that loggers and encoders can format UUIDs into reused
+// buffers.  The hex digits are in lower case regardless of SetUppercase, as
+// for FormatSlice.
+func (uuid UUID) AppendFormat(dst []byte, s Style) []byte {
+	var buf [36 + 9]byte
+	b := buf[:s.size()]
+	encodeStyle(b, uuid, s)
+	return append(dst, b...)
+}
+
+// A Style is a string form of a UUID produced by FormatSlice and, if set with
+// SetTextStyle, by MarshalText.
+type Style int
+
+// Styles of a UUID string.
+const (
+	StyleCanonical Style = iota // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	StyleURN                    // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+	StyleBraced                 // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
+	StyleHex                    // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+	StyleParens                 // (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)
+)
+
+// size returns the length of a UUID in style s.  Unknown styles are treated
+// as StyleCanonical.
+func (s Style) size() int {
+	switch s {
+	case StyleURN:
+		return 36 + 9
+	case StyleBraced, StyleParens:
+		return 36 + 2
+	case StyleHex:
+		return 32
+	}
+	return 36
+}
+
+// encodeStyle writes uuid in style s to dst, which must be s.size() bytes
+// long.
+func encodeStyle(dst []byte, uuid UUID, s Style) {
+	switch s {
+	case StyleURN:
+		copy(dst, "urn:uuid:")
+		encodeHex(dst[9:], uuid)
+	case StyleBraced:
+		dst[0] = '{'
+		encodeHex(dst[1:37], uuid)
+		dst[37] = '}'
+	case StyleParens:
+		dst[0] = '('
+		encodeHex(dst[1:37], uuid)
+		dst[37] = ')'
+	case StyleHex:
+		hex.Encode(dst, uuid[:])
+	default:
+		encodeHex(dst, uuid)
+	}
+}
+
+// upperHex converts the lower case hex digits of b to upper case in place.
+func upperHex(b []byte) {
+	for i, c := range b {
+		if c >= 'a' && c <= 'f' {
+			b[i] = c - 'a' + 'A'
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestAppendFormat(t *testing.T) {
+	for style, want := range map[Style]string{
+		StyleCanonical: "id=f47ac10b-58cc-0372-8567-0e02b2c3d479",
+		StyleURN:       "id=urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d479",
+		StyleBraced:    "id={f47ac10b-58cc-0372-8567-0e02b2c3d479}",
+		StyleParens:    "id=(f47ac10b-58cc-0372-8567-0e02b2c3d479)",
+		StyleHex:       "id=f47ac10b58cc037285670e02b2c3d479",
+	} {
+		if got := string(testUUID.AppendFormat([]byte("id="), style)); got != want {
+			t.Errorf("AppendFormat(%d) = %q, want %q", style, got, want)
+		}
+	}
+}
+
+// TestNoAllocs verifies that the hot paths do not allocate.
+func TestNoAllocs(t *testing.T) {
+	b := testUUID[:]
+	buf := make([]byte, 0, 64)
+	for name, f := range map[string]func(){
+		"FromBytes":    func() { Must(FromBytes(b)) },
+		"Parse":        func() { Must(Parse("f47ac10b-58cc-0372-8567-0e02b2c3d479")) },
+		"ParseBytes":   func() { Must(ParseBytes([]byte("f47ac10b58cc037285670e02b2c3d479"))) },
+		"AppendFormat": func() { buf = testUUID.AppendFormat(buf[:0], StyleURN) },
+	} {
+		if n := testing.AllocsPerRun(100, f); n != 0 {
+			t.Errorf("%s allocates %v times", name, n)
+		}
+	}
+}
+
+func BenchmarkFromBytes(b *testing.B) {
+	data := testUUID[:]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Must(FromBytes(data))
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = testUUID.AppendFormat(buf[:0], StyleCanonical)
+	}
+}
+
+func TestShortString(t *testing.T) {
+	u := MustParse("123e4567-e89b-12d3-a456-426614174000")
+	for _, tt := range []struct {
+		n    int
+		want string
+	}{
+		{-1, "…"},
+		{0, "…"},
+		{1, "1…"},
+		{8, "123e4567…"},
+		{9, "123e4567-e…"},
+		{12, "123e4567-e89b…"},
+		{20, "123e4567-e89b-12d3-a456…"},
+		{21, "123e4567-e89b-12d3-a456-4…"},
+		{31, "123e4567-e89b-12d3-a456-42661417400…"},
+		{32, "123e4567-e89b-12d3-a456-426614174000"},
+		{40, "123e4567-e89b-12d3-a456-426614174000"},
+	} {
+		if got := u.ShortString(tt.n); got != tt.want {
+			t.Errorf("ShortString(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
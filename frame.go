@@ -0,0 +1,81 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+)
+
+// A batch frame, as written by WriteBatch, consists of
+//
+//	count    4 bytes, big endian number of UUIDs
+//	uuids    count * 16 bytes
+//	checksum 4 bytes, big endian CRC-32 (IEEE) of count and uuids
+
+// MaxBatchLen is the largest number of UUIDs ReadBatch accepts in a frame.
+// It protects readers from allocating large amounts of memory for a corrupt
+// or malicious count.
+const MaxBatchLen = 1 << 20
+
+var (
+	// ErrBatchTooLarge is returned by WriteBatch and ReadBatch for frames
+	// of more than MaxBatchLen UUIDs.
+	ErrBatchTooLarge = errors.New("UUID batch too large")
+
+	// ErrBatchChecksum is returned by ReadBatch if the checksum of a frame
+	// does not match its contents.
+	ErrBatchChecksum = errors.New("UUID batch checksum mismatch")
+)
+
+// WriteBatch writes uuids to w as a single frame that can be read by
+// ReadBatch.
+func WriteBatch(w io.Writer, uuids []UUID) error {
+	if len(uuids) > MaxBatchLen {
+		return ErrBatchTooLarge
+	}
+	buf := make([]byte, 4+16*len(uuids)+4)
+	binary.BigEndian.PutUint32(buf, uint32(len(uuids)))
+	for i := range uuids {
+		copy(buf[4+16*i:], uuids[i][:])
+	}
+	n := len(buf) - 4
+	binary.BigEndian.PutUint32(buf[n:], crc32.ChecksumIEEE(buf[:n]))
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadBatch reads a single frame written by WriteBatch from r and returns its
+// UUIDs.  ReadBatch returns io.EOF if r is at its end before the frame and
+// io.ErrUnexpectedEOF if r ends within the frame.
+func ReadBatch(r io.Reader) ([]UUID, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, err
+	}
+	count := binary.BigEndian.Uint32(hdr[:])
+	if count > MaxBatchLen {
+		return nil, ErrBatchTooLarge
+	}
+	buf := make([]byte, 16*int(count)+4)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	n := len(buf) - 4
+	crc := crc32.Update(crc32.ChecksumIEEE(hdr[:]), crc32.IEEETable, buf[:n])
+	if crc != binary.BigEndian.Uint32(buf[n:]) {
+		return nil, ErrBatchChecksum
+	}
+	uuids := make([]UUID, count)
+	for i := range uuids {
+		copy(uuids[i][:], buf[16*i:])
+	}
+	return uuids, nil
+}
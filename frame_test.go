@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestBatchFrame(t *testing.T) {
+	var buf bytes.Buffer
+	batches := [][]UUID{
+		{NameSpaceDNS, NameSpaceURL},
+		{},
+		{Max},
+	}
+	for _, b := range batches {
+		if err := WriteBatch(&buf, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := buf.Len(); n != 3*8+3*16 {
+		t.Errorf("frames have %d bytes, want %d", n, 3*8+3*16)
+	}
+	for _, want := range batches {
+		got, err := ReadBatch(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ReadBatch = %v, want %v", got, want)
+		}
+	}
+	if _, err := ReadBatch(&buf); err != io.EOF {
+		t.Errorf("ReadBatch at end got error %v, want %v", err, io.EOF)
+	}
+}
+
+func TestBatchFrameErrors(t *testing.T) {
+	var buf bytes.Buffer
+	WriteBatch(&buf, []UUID{NameSpaceDNS})
+	frame := buf.Bytes()
+
+	corrupt := append([]byte(nil), frame...)
+	corrupt[10] ^= 1
+	if _, err := ReadBatch(bytes.NewReader(corrupt)); err != ErrBatchChecksum {
+		t.Errorf("corrupt frame got error %v, want %v", err, ErrBatchChecksum)
+	}
+	for _, n := range []int{4, len(frame) - 1} {
+		if _, err := ReadBatch(bytes.NewReader(frame[:n])); err != io.ErrUnexpectedEOF {
+			t.Errorf("frame truncated to %d bytes got error %v, want %v", n, err, io.ErrUnexpectedEOF)
+		}
+	}
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], MaxBatchLen+1)
+	if _, err := ReadBatch(bytes.NewReader(hdr[:])); err != ErrBatchTooLarge {
+		t.Errorf("large count got error %v, want %v", err, ErrBatchTooLarge)
+	}
+	if err := WriteBatch(io.Discard, make([]UUID, MaxBatchLen+1)); err != ErrBatchTooLarge {
+		t.Errorf("WriteBatch got error %v, want %v", err, ErrBatchTooLarge)
+	}
+}
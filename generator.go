@@ -0,0 +1,345 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrFIPSRand is returned by NewGenerator when FIPSOnly is combined
+	// with a source of randomness other than crypto/rand.Reader.
+	ErrFIPSRand = errors.New("FIPS mode requires crypto/rand.Reader")
+
+	// ErrFIPSBuffer is returned by NewGenerator when FIPSOnly is combined
+	// with WithBufferSize.
+	ErrFIPSBuffer = errors.New("FIPS mode does not allow buffered randomness")
+)
+
+// A Generator generates UUIDs using its own source of randomness and its own
+// Version 7 clock state.  Unlike the package level functions such as New and
+// NewV7, which share the configuration set by SetRand and EnableRandPool, a
+// Generator is configured once by NewGenerator and is not affected by later
+// changes to the package configuration.
+//
+// A Generator is safe for concurrent use by multiple goroutines.
+type Generator struct {
+	rand        io.Reader
+	bufSize     int
+	bufWipe     bool
+	fips        bool
+	granularity int64 // in milliseconds
+	watermark   bool
+	instanceID  uint16
+	skewLimit   time.Duration
+	skewMonitor SkewMonitor
+	clock       func() time.Time
+	store       StateStore
+	lockPath    string
+	lockFile    *lockFile
+
+	mu       sync.Mutex
+	lastV7   int64 // protected by mu, see lastV7time
+	lastNano int64 // protected by mu, time observed by the last reserveV7Time
+	leased   int64 // protected by mu, bound of lastV7 saved in store
+}
+
+// A GeneratorOption configures a Generator created by NewGenerator.
+type GeneratorOption func(*Generator)
+
+// WithRand sets the source of randomness used by the Generator to r.  If r is
+// nil, or WithRand is not used, crypto/rand.Reader is used.
+func WithRand(r io.Reader) GeneratorOption {
+	return func(g *Generator) {
+		g.rand = r
+	}
+}
+
+// WithBufferSize makes the Generator read random bytes in blocks of size bytes
+// through a BufferedReader, which may improve the UUID generation throughput
+// significantly.  Batch workers may use a few KiB, such as 4096.  A size of 0,
+// the default, disables buffering.
+func WithBufferSize(size int) GeneratorOption {
+	return func(g *Generator) {
+		g.bufSize = size
+	}
+}
+
+// WithTimestampGranularity makes the Generator round the time of Version 7
+// UUIDs down to a multiple of d, such as time.Hour, for products that must not
+// disclose precise creation times.  The UUIDs of the Generator remain strictly
+// increasing: rand_a, which otherwise holds a fraction of the millisecond, is
+// used as a counter that starts at 0 in each period.  Once the counter
+// overflows the millisecond is incremented.  Durations of less than a
+// millisecond are ignored.
+func WithTimestampGranularity(d time.Duration) GeneratorOption {
+	return func(g *Generator) {
+		g.granularity = int64(d / time.Millisecond)
+	}
+}
+
+// A SkewMonitor is called by a Generator when the wall clock moved backwards
+// between two generations of Version 7 UUIDs by more than the threshold set
+// with WithSkewMonitor.  prev is the time observed by the earlier generation
+// and now the time observed by the later one.
+type SkewMonitor func(prev, now time.Time)
+
+// WithSkewMonitor makes the Generator call f when the wall clock moved
+// backwards by more than threshold between two generations of Version 7
+// UUIDs, such as after a step correction by NTP, so that operators are
+// alerted that the UUIDs no longer follow the wall clock: until the clock
+// catches up, the Generator keeps its UUIDs increasing by using the time of
+// the last UUID.  Forward jumps are not reported, as they cannot be told
+// apart from idle periods.
+//
+// f is called synchronously by the generating goroutine, without holding
+// the lock of the Generator, and should return quickly.
+func WithSkewMonitor(threshold time.Duration, f SkewMonitor) GeneratorOption {
+	return func(g *Generator) {
+		g.skewLimit = threshold
+		g.skewMonitor = f
+	}
+}
+
+// stateLease is the time, in milliseconds, by which the Version 7 clock state
+// saved by a Generator created with WithStateStore is ahead of its UUIDs.
+const stateLease = 1000
+
+// WithStateStore makes the Generator persist its Version 7 clock state in
+// store, so that the Version 7 UUIDs of a Generator created with the same
+// store after a restart, even a rapid one, are greater than all those issued
+// before, as billing and event sourcing systems require.
+//
+// NewGenerator loads the state of store, and returns an error if it cannot.
+// To keep the writes to store rare, the Generator saves a bound a second
+// ahead of the UUIDs it issues, each time its UUIDs reach the previous
+// bound.  The UUIDs generated right after a restart may so have a time up to
+// a second in the future.  NewV7 and NewV7Batch return the errors of store,
+// without UUIDs.
+//
+// Only one Generator may use a store at a time.
+func WithStateStore(store StateStore) GeneratorOption {
+	return func(g *Generator) {
+		g.store = store
+	}
+}
+
+// FIPSOnly restricts the Generator to the FIPS 140 validated random number
+// generator for regulated deployments.  When the Go Cryptographic Module is
+// in FIPS mode (GODEBUG=fips140=on, Go 1.24 and later), crypto/rand.Reader is
+// backed by its validated DRBG, and FIPSOnly guarantees that every random bit
+// of the generated UUIDs is read directly from crypto/rand.Reader:
+// NewGenerator returns ErrFIPSRand if any other io.Reader, including one
+// wrapping crypto/rand.Reader, is configured, and ErrFIPSBuffer if a buffer
+// size is set with WithBufferSize.
+//
+// FIPSOnly does not itself enable FIPS mode of the Go runtime.
+func FIPSOnly() GeneratorOption {
+	return func(g *Generator) {
+		g.fips = true
+	}
+}
+
+// NewGenerator returns a new Generator configured by opts.
+func NewGenerator(opts ...GeneratorOption) (*Generator, error) {
+	g := &Generator{}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.rand == nil {
+		g.rand = rand.Reader
+	}
+	if g.fips {
+		if g.rand != rand.Reader {
+			return nil, ErrFIPSRand
+		}
+		if g.bufSize > 0 {
+			return nil, ErrFIPSBuffer
+		}
+	}
+	if g.lockPath != "" {
+		l, err := openLockFile(g.lockPath)
+		if err != nil {
+			return nil, err
+		}
+		g.lockFile = l
+	}
+	if g.store != nil {
+		s, err := g.store.Load()
+		if err != nil {
+			return nil, err
+		}
+		g.lastV7 = s.V7Milli<<12 + s.V7Seq
+		g.leased = g.lastV7
+	}
+	if g.bufSize > 0 {
+		b := NewBufferedReader(g.rand, g.bufSize)
+		b.SetWipe(g.bufWipe)
+		g.rand = b
+	}
+	return g, nil
+}
+
+// FIPS reports whether g was created with the FIPSOnly option.
+func (g *Generator) FIPS() bool {
+	return g.fips
+}
+
+// NewRandom returns a Random (Version 4) UUID using the source of randomness
+// of g.
+func (g *Generator) NewRandom() (UUID, error) {
+	uuid, err := NewRandomFromReader(g.rand)
+	if err == nil && g.watermark {
+		putWatermark(&uuid, g.instanceID)
+	}
+	return uuid, err
+}
+
+// NewV7 returns a Version 7 UUID based on the current time.  The UUIDs
+// returned by g are strictly increasing, independent of the UUIDs returned by
+// the package level NewV7 or by other Generators.
+func (g *Generator) NewV7() (UUID, error) {
+	uuid, err := g.NewRandom()
+	if err != nil {
+		return uuid, err
+	}
+	t, err := g.reserveV7Time(1)
+	if err != nil {
+		return Nil, err
+	}
+	putV7Time(uuid[:], t>>12, t&0xfff)
+	if g.watermark {
+		putWatermark(&uuid, g.instanceID)
+	}
+	return uuid, nil
+}
+
+// NewV7Batch is like the package level NewV7Batch but uses the source of
+// randomness, clock state and options of g.  The UUIDs are strictly increasing
+// and greater than all UUIDs previously returned by the NewV7 and NewV7Batch
+// methods of g.  An error is returned if n is negative.
+func (g *Generator) NewV7Batch(n int) ([]UUID, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid UUID batch size: %d", n)
+	}
+	uuids := make([]UUID, n)
+	for i := range uuids {
+		var err error
+		if uuids[i], err = g.NewRandom(); err != nil {
+			return nil, err
+		}
+	}
+	if n == 0 {
+		return uuids, nil
+	}
+	first, err := g.reserveV7Time(n)
+	if err != nil {
+		return nil, err
+	}
+	putV7Batch(uuids, first)
+	if g.watermark {
+		for i := range uuids {
+			putWatermark(&uuids[i], g.instanceID)
+		}
+	}
+	return uuids, nil
+}
+
+// reserveV7Time reserves n consecutive times in the format of lastV7time and
+// returns the first.  An error is returned if the clock state of g cannot be
+// saved.
+func (g *Generator) reserveV7Time(n int) (int64, error) {
+	clock := g.clock
+	if clock == nil {
+		clock = timeNow
+	}
+	g.mu.Lock()
+	// The clock is read under the lock, so that concurrent generations observe
+	// it in the order in which they update lastNano.
+	nano := clock().UnixNano()
+	prev := g.lastNano
+	g.lastNano = nano
+	if g.lockFile != nil {
+		shared, err := g.lockFile.lock()
+		if err != nil {
+			g.mu.Unlock()
+			return 0, err
+		}
+		if shared > g.lastV7 {
+			g.lastV7 = shared
+		}
+	}
+	var first int64
+	if g.granularity <= 1 {
+		milli, seq := nextV7Time(&g.lastV7, nano)
+		first = milli<<12 + seq
+	} else {
+		milli := nano / nanoPerMilli
+		first = (milli - milli%g.granularity) << 12
+		if first <= g.lastV7 {
+			first = g.lastV7 + 1
+		}
+	}
+	g.lastV7 = first + int64(n-1)
+	var err error
+	if g.lockFile != nil {
+		err = g.lockFile.unlock(g.lastV7)
+	}
+	if err == nil && g.store != nil && g.lastV7 > g.leased {
+		err = g.lease()
+	}
+	g.mu.Unlock()
+	if g.skewMonitor != nil && prev-nano > int64(g.skewLimit) {
+		g.skewMonitor(time.Unix(0, prev), time.Unix(0, nano))
+	}
+	return first, err
+}
+
+// lease saves in the store of g a bound stateLease milliseconds ahead of the
+// last Version 7 time of g, and makes it the time from which g starts after a
+// restart.  The caller must hold g.mu.
+func (g *Generator) lease() error {
+	return g.save(g.lastV7 + stateLease<<12)
+}
+
+// save saves t, a time in the format of lastV7time, as the Version 7 clock
+// state in the store of g.  The caller must hold g.mu.
+func (g *Generator) save(t int64) error {
+	s, err := g.store.Load()
+	if err != nil {
+		return err
+	}
+	s.V7Milli, s.V7Seq = t>>12, t&0xfff
+	if err := g.store.Save(s); err != nil {
+		return err
+	}
+	g.leased = t
+	return nil
+}
+
+// Close releases the resources of g.  It closes the file of WithLockFile and
+// saves the last Version 7 time of g in the store of WithStateStore, rather
+// than the bound a second ahead, so that a Generator created with the store
+// after a clean shutdown does not issue UUIDs with a time in the future.  g
+// must not be used after Close.
+func (g *Generator) Close() error {
+	defer g.mu.Unlock()
+	g.mu.Lock()
+	var err error
+	if g.store != nil {
+		err = g.save(g.lastV7)
+	}
+	if g.lockFile != nil {
+		if cerr := g.lockFile.close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
@@ -0,0 +1,352 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGenerator(t *testing.T) {
+	g, err := NewGenerator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid, err := g.NewRandom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := uuid.Version(); v != 4 {
+		t.Errorf("NewRandom returned %s", v)
+	}
+	u1, err := g.NewV7()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := u1.Version(); v != 7 {
+		t.Errorf("NewV7 returned %s", v)
+	}
+	for i := 0; i < 10000; i++ {
+		u2 := Must(g.NewV7())
+		if Compare(u1, u2) >= 0 {
+			t.Fatalf("monotonicity failed at #%d: %s(next) <= %s(before)", i, u2, u1)
+		}
+		u1 = u2
+	}
+}
+
+func TestGeneratorIndependentClock(t *testing.T) {
+	timeNow = func() time.Time {
+		return time.Date(2008, 8, 8, 8, 8, 8, 8, time.UTC)
+	}
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	g1, _ := NewGenerator(WithRand(fakeRand{}))
+	g2, _ := NewGenerator(WithRand(fakeRand{}))
+	if u1, u2 := Must(g1.NewV7()), Must(g2.NewV7()); u1 != u2 {
+		t.Errorf("generators share state: %s != %s", u1, u2)
+	}
+}
+
+func TestGeneratorWithRand(t *testing.T) {
+	const s = "8059ddhdle77cb52"
+	g, err := NewGenerator(WithRand(strings.NewReader(s)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid, err := g.NewRandom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Must(NewRandomFromReader(strings.NewReader(s))); uuid != want {
+		t.Errorf("got %s, want %s", uuid, want)
+	}
+	if _, err := g.NewRandom(); err == nil {
+		t.Errorf("expected an error as reader has no more bytes")
+	}
+}
+
+func TestFIPSOnly(t *testing.T) {
+	g, err := NewGenerator(FIPSOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !g.FIPS() {
+		t.Errorf("FIPS() = false, want true")
+	}
+	if _, err := NewGenerator(FIPSOnly(), WithRand(rand.Reader)); err != nil {
+		t.Errorf("NewGenerator(FIPSOnly(), WithRand(rand.Reader)): %v", err)
+	}
+	for _, opts := range [][]GeneratorOption{
+		{FIPSOnly(), WithRand(fakeRand{})},
+		{WithRand(bufio.NewReader(rand.Reader)), FIPSOnly()},
+	} {
+		if _, err := NewGenerator(opts...); err != ErrFIPSRand {
+			t.Errorf("NewGenerator got error %v, want %v", err, ErrFIPSRand)
+		}
+	}
+}
+
+func TestWithTimestampGranularity(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 123456789, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	g, err := NewGenerator(WithTimestampGranularity(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hour := now.Truncate(time.Hour)
+	u1 := Must(g.NewV7())
+	if sec, nsec := u1.Time().UnixTime(); !time.Unix(sec, nsec).Equal(hour) {
+		t.Errorf("got time %v, want %v", time.Unix(sec, nsec).UTC(), hour)
+	}
+	if u1[6]&0x0f != 0 || u1[7] != 0 {
+		t.Errorf("rand_a of the first UUID is %x, want 0", u1[6:8])
+	}
+	for i := 0; i < 5000; i++ {
+		now = now.Add(time.Microsecond)
+		u2 := Must(g.NewV7())
+		if Compare(u1, u2) >= 0 {
+			t.Fatalf("monotonicity failed at #%d: %s(next) <= %s(before)", i, u2, u1)
+		}
+		u1 = u2
+	}
+	if sec, nsec := u1.Time().UnixTime(); !time.Unix(sec, nsec).Equal(hour.Add(time.Millisecond)) {
+		t.Errorf("got time %v after counter overflow, want %v", time.Unix(sec, nsec).UTC(), hour.Add(time.Millisecond))
+	}
+}
+
+func TestNewV7Batch(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 999990000, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	g, _ := NewGenerator()
+	for name, batch := range map[string]func(int) ([]UUID, error){
+		"NewV7Batch":           NewV7Batch,
+		"Generator.NewV7Batch": g.NewV7Batch,
+	} {
+		var last UUID
+		for _, n := range []int{0, 1, 3000, 5000} {
+			uuids, err := batch(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(uuids) != n {
+				t.Fatalf("%s(%d) returned %d UUIDs", name, n, len(uuids))
+			}
+			for i, u := range uuids {
+				if u.Version() != 7 {
+					t.Fatalf("%s returned %s", name, u)
+				}
+				if Compare(last, u) >= 0 {
+					t.Fatalf("%s(%d): UUID #%d %s not greater than %s", name, n, i, u, last)
+				}
+				last = u
+			}
+		}
+		if uuids, err := batch(-1); err == nil {
+			t.Errorf("%s(-1) returned %d UUIDs and no error", name, len(uuids))
+		}
+		// The counter spilled into the following milliseconds.
+		if sec, nsec := last.Time().UnixTime(); !time.Unix(sec, nsec).After(now) {
+			t.Errorf("%s: last UUID time %v, want after %v", name, time.Unix(sec, nsec).UTC(), now)
+		}
+	}
+	u := Must(g.NewV7())
+	if b, _ := g.NewV7Batch(1); Compare(u, b[0]) >= 0 {
+		t.Errorf("NewV7Batch returned a UUID not greater than the one of NewV7")
+	}
+}
+
+func TestWithSkewMonitor(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	var jumps [][2]time.Time
+	g, _ := NewGenerator(WithSkewMonitor(time.Second, func(prev, now time.Time) {
+		jumps = append(jumps, [2]time.Time{prev, now})
+	}))
+	u1 := Must(g.NewV7())
+	now = now.Add(-500 * time.Millisecond)
+	u2 := Must(g.NewV7())
+	now = now.Add(time.Hour)
+	Must(g.NewV7())
+	before := now
+	now = now.Add(-2 * time.Second)
+	u3 := Must(g.NewV7())
+	if _, err := g.NewV7Batch(3); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(jumps) != 1 {
+		t.Fatalf("got %d jumps reported, want 1", len(jumps))
+	}
+	if !jumps[0][0].Equal(before) || !jumps[0][1].Equal(now) {
+		t.Errorf("got jump from %v to %v, want from %v to %v", jumps[0][0], jumps[0][1], before, now)
+	}
+	if Compare(u1, u2) >= 0 || Compare(u2, u3) >= 0 {
+		t.Errorf("UUIDs not increasing across clock jumps: %s, %s, %s", u1, u2, u3)
+	}
+}
+
+func TestWithSkewMonitorConcurrent(t *testing.T) {
+	var nano int64
+	timeNow = func() time.Time {
+		t := time.Unix(0, atomic.AddInt64(&nano, 1))
+		runtime.Gosched() // let other generations read the clock meanwhile
+		return t
+	}
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	var jumps int32
+	g, _ := NewGenerator(WithSkewMonitor(0, func(prev, now time.Time) {
+		atomic.AddInt32(&jumps, 1)
+	}))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				Must(g.NewV7())
+			}
+		}()
+	}
+	wg.Wait()
+	if jumps != 0 {
+		t.Errorf("got %d jumps reported for a monotonic clock, want 0", jumps)
+	}
+}
+
+// memStateStore is a StateStore in memory, counting the saves.
+type memStateStore struct {
+	s     State
+	saves int
+	err   error
+}
+
+func (m *memStateStore) Load() (State, error) { return m.s, nil }
+
+func (m *memStateStore) Save(s State) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.s = s
+	m.saves++
+	return nil
+}
+
+func TestWithStateStore(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	store := &memStateStore{s: State{NodeID: []byte{1, 2, 3, 4, 5, 6}}}
+	g, err := NewGenerator(WithStateStore(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var last UUID
+	for i := 0; i < 100; i++ {
+		now = now.Add(time.Millisecond)
+		last = Must(g.NewV7())
+	}
+	if store.saves != 1 {
+		t.Errorf("got %d saves, want 1", store.saves)
+	}
+	if len(store.s.NodeID) != 6 {
+		t.Errorf("NodeID of State lost")
+	}
+
+	// A restart with a clock that went backwards.
+	now = now.Add(-time.Minute)
+	g, err = NewGenerator(WithStateStore(store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := Must(g.NewV7())
+	if Compare(last, u) >= 0 {
+		t.Errorf("UUID after restart %s not greater than %s", u, last)
+	}
+	b, err := g.NewV7Batch(5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.saves != 2 {
+		t.Errorf("got %d saves, want 2", store.saves)
+	}
+	var bound UUID
+	putV7Time(bound[:], store.s.V7Milli, store.s.V7Seq)
+	if bytes.Compare(bound[:8], b[4999][:8]) < 0 {
+		t.Errorf("saved bound %s below UUID %s", bound, b[4999])
+	}
+
+	store.err = errors.New("disk full")
+	now = now.Add(time.Hour)
+	if _, err := g.NewV7(); err != store.err {
+		t.Errorf("NewV7 returned %v, want %v", err, store.err)
+	}
+	if _, err := g.NewV7Batch(1); err != store.err {
+		t.Errorf("NewV7Batch returned %v, want %v", err, store.err)
+	}
+}
+
+func TestGeneratorClose(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	store := &memStateStore{}
+	g, _ := NewGenerator(WithStateStore(store))
+	last := Must(g.NewV7())
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	var saved UUID
+	putV7Time(saved[:], store.s.V7Milli, store.s.V7Seq)
+	if !bytes.Equal(saved[:8], last[:8]) {
+		t.Errorf("Close saved %s, want the time of %s", saved, last)
+	}
+
+	// A clean restart does not start a second ahead.
+	g, _ = NewGenerator(WithStateStore(store))
+	u := Must(g.NewV7())
+	if Compare(last, u) >= 0 {
+		t.Errorf("UUID after restart %s not greater than %s", u, last)
+	}
+	if sec, nsec := u.Time().UnixTime(); !time.Unix(sec, nsec).Equal(now) {
+		t.Errorf("UUID after restart has time %v, want %v", time.Unix(sec, nsec).UTC(), now)
+	}
+
+	store.err = errors.New("disk full")
+	if err := g.Close(); err != store.err {
+		t.Errorf("Close returned %v, want %v", err, store.err)
+	}
+}
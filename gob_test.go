@@ -0,0 +1,41 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+)
+
+// TestGob checks that UUID and NullUUID, including invalid NullUUIDs, survive
+// gob, which encodes them with MarshalBinary.
+func TestGob(t *testing.T) {
+	type S struct {
+		ID    UUID
+		IDs   []UUID
+		Null  NullUUID
+		Valid NullUUID
+		Ptr   *NullUUID
+	}
+	s1 := S{
+		ID:    testUUID,
+		IDs:   []UUID{NameSpaceDNS, Nil, Max},
+		Valid: NullUUID{UUID: NameSpaceURL, Valid: true},
+		Ptr:   &NullUUID{UUID: NameSpaceOID, Valid: true},
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&s1); err != nil {
+		t.Fatal(err)
+	}
+	var s2 S
+	if err := gob.NewDecoder(&buf).Decode(&s2); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s1, s2) {
+		t.Errorf("got %#v, want %#v", s2, s1)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrGroupStateStore is returned by NewGeneratorGroup when WithStateStore is
+// used, as a StateStore holds the clock state of a single Generator.
+var ErrGroupStateStore = errors.New("WithStateStore cannot be used with a GeneratorGroup")
+
+// A GeneratorGroup manages a Generator per key, such as a tenant, so each
+// tenant receives strictly increasing Version 7 UUIDs without sharing one
+// clock state with all other tenants.  The Generators of the least recently
+// used keys are evicted and closed once the group holds size of them, so the
+// Generators returned by For should not be kept but looked up for each use.
+// The ordering of the UUIDs of a key is only guaranteed across an eviction if
+// the clock has advanced by a millisecond since the last UUID of the key.
+//
+// A GeneratorGroup is safe for concurrent use by multiple goroutines.
+type GeneratorGroup struct {
+	opts []GeneratorOption
+
+	mu   sync.Mutex
+	gens *lru // protected by mu, of *Generator by string
+}
+
+// NewGeneratorGroup returns a GeneratorGroup holding up to size Generators,
+// each created by NewGenerator with opts.  The error of NewGenerator for opts,
+// if any, is returned, and ErrGroupStateStore if opts include WithStateStore.
+func NewGeneratorGroup(size int, opts ...GeneratorOption) (*GeneratorGroup, error) {
+	var cfg Generator
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.store != nil {
+		return nil, ErrGroupStateStore
+	}
+	g, err := NewGenerator(opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.Close(); err != nil {
+		return nil, err
+	}
+	gens := newLRU(size)
+	// Without a StateStore, Close can only fail to close a lock file, which
+	// holds no data that could be lost.
+	gens.evict = func(v interface{}) { v.(*Generator).Close() } //nolint:errcheck
+	return &GeneratorGroup{opts: opts, gens: gens}, nil
+}
+
+// For returns the Generator of key, creating it if necessary.  The error of
+// NewGenerator, such as one opening the file of WithLockFile, is returned.
+func (gg *GeneratorGroup) For(key string) (*Generator, error) {
+	defer gg.mu.Unlock()
+	gg.mu.Lock()
+	if g, ok := gg.gens.get(key); ok {
+		return g.(*Generator), nil
+	}
+	g, err := NewGenerator(gg.opts...)
+	if err != nil {
+		return nil, err
+	}
+	gg.gens.add(key, g)
+	return g, nil
+}
+
+// Len returns the number of Generators held by gg.
+func (gg *GeneratorGroup) Len() int {
+	defer gg.mu.Unlock()
+	gg.mu.Lock()
+	return gg.gens.len()
+}
+
+// Close closes the Generators held by gg, returning the first error of their
+// Close methods.  gg must not be used after Close.
+func (gg *GeneratorGroup) Close() error {
+	defer gg.mu.Unlock()
+	gg.mu.Lock()
+	var err error
+	for e := gg.gens.ll.Front(); e != nil; e = e.Next() {
+		if cerr := e.Value.(*lruEntry).value.(*Generator).Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
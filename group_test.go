@@ -0,0 +1,108 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGeneratorGroup(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	gg, err := NewGeneratorGroup(2, WithRand(fakeRand{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := gg.For("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := gg.For("a"); g != a {
+		t.Errorf("For returned a new Generator for the same key")
+	}
+	// Tenants have independent clock states.
+	b, _ := gg.For("b")
+	if ua, ub := Must(a.NewV7()), Must(b.NewV7()); ua != ub {
+		t.Errorf("tenants share state: %s != %s", ua, ub)
+	}
+	gg.For("a")
+	gg.For("c") // evicts b
+	if n := gg.Len(); n != 2 {
+		t.Errorf("Len() = %d, want 2", n)
+	}
+	if g, _ := gg.For("a"); g != a {
+		t.Errorf("recently used Generator was evicted")
+	}
+
+	if _, err := NewGeneratorGroup(2, FIPSOnly(), WithRand(strings.NewReader(""))); err != ErrFIPSRand {
+		t.Errorf("NewGeneratorGroup got error %v, want %v", err, ErrFIPSRand)
+	}
+	store := &memStateStore{}
+	if _, err := NewGeneratorGroup(2, WithStateStore(store)); err != ErrGroupStateStore {
+		t.Errorf("NewGeneratorGroup got error %v, want %v", err, ErrGroupStateStore)
+	}
+}
+
+func TestGeneratorGroupLockFile(t *testing.T) {
+	if !flockSupported {
+		t.Skip("no file locks")
+	}
+	dir := filepath.Join(t.TempDir(), "locks")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	gg, err := NewGeneratorGroup(2, WithLockFile(filepath.Join(dir, "uuid.lock")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := gg.For("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gg.For("b")
+	gg.For("c") // evicts and closes a
+	if _, err := a.NewV7(); err == nil {
+		t.Errorf("evicted Generator was not closed")
+	}
+	if err := gg.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+
+	os.RemoveAll(dir)
+	if g, err := gg.For("d"); g != nil || err == nil {
+		t.Errorf("For returned %v, %v, want an error", g, err)
+	}
+}
+
+func TestLRU(t *testing.T) {
+	c := newLRU(2)
+	var evicted []interface{}
+	c.evict = func(v interface{}) { evicted = append(evicted, v) }
+	c.add(1, "one")
+	c.add(2, "two")
+	c.get(1)
+	c.add(3, "three")
+	if _, ok := c.get(2); ok {
+		t.Errorf("least recently used key 2 was not evicted")
+	}
+	if len(evicted) != 1 || evicted[0] != "two" {
+		t.Errorf("evicted %v, want [two]", evicted)
+	}
+	if v, ok := c.get(1); !ok || v != "one" {
+		t.Errorf("get(1) = %v, %v, want one, true", v, ok)
+	}
+	c.add(1, "uno")
+	if v, _ := c.get(1); v != "uno" || c.len() != 2 {
+		t.Errorf("get(1) = %v with len %d, want uno with len 2", v, c.len())
+	}
+}
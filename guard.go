@@ -0,0 +1,93 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrCollision is returned by CollisionGuard.Next when the guarded function
+// keeps returning UUIDs it has recently returned.
+var ErrCollision = errors.New("UUID collision")
+
+// collisionRetries is the number of times CollisionGuard.Next generates a new
+// UUID after a collision before giving up.
+const collisionRetries = 3
+
+// A CollisionGuard wraps a function generating UUIDs, such as NewV7 or the
+// NewRandom method of a Generator, and guarantees that it never returns a
+// UUID that is among the last window UUIDs it returned.  Duplicates are
+// regenerated and counted.  A CollisionGuard is meant as a safety net for
+// custom sources of randomness (see SetRand and WithRand) of questionable
+// quality; with crypto/rand collisions are practically impossible.
+//
+// A CollisionGuard is safe for concurrent use by multiple goroutines.
+type CollisionGuard struct {
+	gen func() (UUID, error)
+
+	mu         sync.Mutex
+	ring       []UUID // protected by mu
+	next       int    // protected by mu, index of the oldest UUID in ring
+	seen       map[UUID]struct{}
+	collisions uint64 // protected by mu
+}
+
+// NewCollisionGuard returns a CollisionGuard for gen remembering the last
+// window UUIDs.  A window of 0 or less disables the guard.
+func NewCollisionGuard(gen func() (UUID, error), window int) *CollisionGuard {
+	if window < 0 {
+		window = 0
+	}
+	return &CollisionGuard{
+		gen:  gen,
+		ring: make([]UUID, 0, window),
+		seen: make(map[UUID]struct{}, window),
+	}
+}
+
+// Next returns the next UUID of the guarded function.  If the function returns
+// a UUID that is still remembered, Next calls it again, up to 3 times, before
+// returning Nil and ErrCollision.  Errors of the function are returned as is.
+func (c *CollisionGuard) Next() (UUID, error) {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+	for i := 0; i <= collisionRetries; i++ {
+		uuid, err := c.gen()
+		if err != nil {
+			return Nil, err
+		}
+		if _, ok := c.seen[uuid]; ok {
+			c.collisions++
+			continue
+		}
+		c.remember(uuid)
+		return uuid, nil
+	}
+	return Nil, ErrCollision
+}
+
+// remember adds uuid to the window, evicting the oldest UUID if the window is
+// full.  c.mu must be held.
+func (c *CollisionGuard) remember(uuid UUID) {
+	switch {
+	case cap(c.ring) == 0:
+		return
+	case len(c.ring) < cap(c.ring):
+		c.ring = append(c.ring, uuid)
+	default:
+		delete(c.seen, c.ring[c.next])
+		c.ring[c.next] = uuid
+		c.next = (c.next + 1) % len(c.ring)
+	}
+	c.seen[uuid] = struct{}{}
+}
+
+// Collisions returns the number of duplicate UUIDs rejected by c so far.
+func (c *CollisionGuard) Collisions() uint64 {
+	defer c.mu.Unlock()
+	c.mu.Lock()
+	return c.collisions
+}
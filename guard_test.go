@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+// sequence returns a generator function returning ids in turn.
+func sequence(ids ...UUID) func() (UUID, error) {
+	return func() (UUID, error) {
+		if len(ids) == 0 {
+			return Nil, errors.New("sequence exhausted")
+		}
+		uuid := ids[0]
+		ids = ids[1:]
+		return uuid, nil
+	}
+}
+
+func TestCollisionGuard(t *testing.T) {
+	a, b, c := NameSpaceDNS, NameSpaceURL, NameSpaceOID
+	g := NewCollisionGuard(sequence(a, a, b, a, c, a), 2)
+	for i, want := range []UUID{a, b, c, a} {
+		got, err := g.Next()
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if got != want {
+			t.Errorf("#%d: got %s, want %s", i, got, want)
+		}
+	}
+	if n := g.Collisions(); n != 2 {
+		t.Errorf("Collisions() = %d, want 2", n)
+	}
+}
+
+func TestCollisionGuardDegenerate(t *testing.T) {
+	g := NewCollisionGuard(func() (UUID, error) {
+		return NewRandomFromReader(fakeRand{})
+	}, 16)
+	if _, err := g.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if uuid, err := g.Next(); err != ErrCollision || uuid != Nil {
+		t.Errorf("Next got (%s, %v), want (%s, %v)", uuid, err, Nil, ErrCollision)
+	}
+	if n := g.Collisions(); n != collisionRetries+1 {
+		t.Errorf("Collisions() = %d, want %d", n, collisionRetries+1)
+	}
+}
+
+func TestCollisionGuardDisabled(t *testing.T) {
+	a := NameSpaceDNS
+	g := NewCollisionGuard(sequence(a, a), 0)
+	for i := 0; i < 2; i++ {
+		if got, err := g.Next(); err != nil || got != a {
+			t.Errorf("#%d: got (%s, %v), want (%s, <nil>)", i, got, err, a)
+		}
+	}
+	if _, err := g.Next(); err == nil {
+		t.Errorf("expected the error of the guarded function")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/binary"
+
+// A GUID has the memory layout of the GUID structure of the Windows API and
+// COM, for cgo and syscall callers.  A *GUID can be passed where the Windows
+// API expects a GUID* or REFGUID.
+type GUID struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
+
+// FromGUID returns the UUID of g.  The fields of g hold the UUID in big endian
+// order: Data1 holds bytes 0 to 3 of the UUID, Data2 bytes 4 and 5, Data3
+// bytes 6 and 7 and Data4 the remaining bytes.
+func FromGUID(g GUID) UUID {
+	var uuid UUID
+	binary.BigEndian.PutUint32(uuid[0:], g.Data1)
+	binary.BigEndian.PutUint16(uuid[4:], g.Data2)
+	binary.BigEndian.PutUint16(uuid[6:], g.Data3)
+	copy(uuid[8:], g.Data4[:])
+	return uuid
+}
+
+// GUID returns uuid as a GUID.  The String method of the GUID of uuid, like
+// the StringFromGUID2 function of Windows, formats uuid with braces.
+func (uuid UUID) GUID() GUID {
+	g := GUID{
+		Data1: binary.BigEndian.Uint32(uuid[0:]),
+		Data2: binary.BigEndian.Uint16(uuid[4:]),
+		Data3: binary.BigEndian.Uint16(uuid[6:]),
+	}
+	copy(g.Data4[:], uuid[8:])
+	return g
+}
+
+// String returns g in the registry format of Windows,
+// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}, in upper case.
+func (g GUID) String() string {
+	var buf [38]byte
+	buf[0] = '{'
+	encodeHex(buf[1:37], FromGUID(g))
+	buf[37] = '}'
+	upperHex(buf[:])
+	return string(buf[:])
+}
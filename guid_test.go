@@ -0,0 +1,42 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestGUID(t *testing.T) {
+	// IID_IUnknown
+	g := GUID{0x00000000, 0x0000, 0x0000, [8]byte{0xc0, 0, 0, 0, 0, 0, 0, 0x46}}
+	uuid := FromGUID(g)
+	if want := MustParse("00000000-0000-0000-c000-000000000046"); uuid != want {
+		t.Errorf("FromGUID = %s, want %s", uuid, want)
+	}
+	if got := uuid.GUID(); got != g {
+		t.Errorf("GUID() = %+v, want %+v", got, g)
+	}
+	g = NameSpaceDNS.GUID()
+	if g.Data1 != 0x6ba7b810 || g.Data2 != 0x9dad || g.Data3 != 0x11d1 || g.Data4[0] != 0x80 {
+		t.Errorf("GUID() = %+v", g)
+	}
+	if s, want := g.String(), "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"; s != want {
+		t.Errorf("String() = %s, want %s", s, want)
+	}
+	if n := unsafe.Sizeof(g); n != 16 {
+		t.Errorf("GUID has %d bytes, want 16", n)
+	}
+	// In memory, on little endian machines, a GUID has the bytes of an
+	// objectGUID.
+	if b := *(*[16]byte)(unsafe.Pointer(&g)); isLittleEndian() && b != NameSpaceDNS.ObjectGUIDBytes() {
+		t.Errorf("GUID memory = %x, want %x", b, NameSpaceDNS.ObjectGUIDBytes())
+	}
+}
+
+func isLittleEndian() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "sync"
+
+// An Interner returns shared strings for the UUIDs it formats, so that
+// formatting the same UUIDs over and over, such as the request ID logged on
+// every log line of a request, does not allocate a new string each time.  The
+// strings of the least recently used UUIDs are evicted once the Interner holds
+// size of them.
+//
+// An Interner is safe for concurrent use by multiple goroutines.
+type Interner struct {
+	mu      sync.Mutex
+	strings *lru // protected by mu, of string by internKey
+}
+
+type internKey struct {
+	uuid  UUID
+	upper bool
+}
+
+// NewInterner returns an Interner holding the strings of up to size UUIDs.
+func NewInterner(size int) *Interner {
+	return &Interner{strings: newLRU(size)}
+}
+
+// String returns uuid.String(), sharing the string with previous calls for
+// the same UUID.
+func (in *Interner) String(uuid UUID) string {
+	key := internKey{uuid, loadConfig().upper}
+	defer in.mu.Unlock()
+	in.mu.Lock()
+	if s, ok := in.strings.get(key); ok {
+		return s.(string)
+	}
+	s := uuid.String()
+	in.strings.add(key, s)
+	return s
+}
+
+// Len returns the number of strings held by in.
+func (in *Interner) Len() int {
+	defer in.mu.Unlock()
+	in.mu.Lock()
+	return in.strings.len()
+}
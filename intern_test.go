@@ -0,0 +1,35 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestInterner(t *testing.T) {
+	defer Restore(Snapshot())
+	in := NewInterner(2)
+	s1 := in.String(testUUID)
+	if s1 != testUUID.String() {
+		t.Errorf("String() = %s, want %s", s1, testUUID)
+	}
+	s2 := in.String(testUUID)
+	if (*[2]uintptr)(unsafe.Pointer(&s1))[0] != (*[2]uintptr)(unsafe.Pointer(&s2))[0] {
+		t.Errorf("String returned a new string for the same UUID")
+	}
+	if n := testing.AllocsPerRun(100, func() { in.String(testUUID) }); n > 1 {
+		t.Errorf("String allocated %v times", n)
+	}
+	SetUppercase(true)
+	if s := in.String(testUUID); s != testUUID.String() {
+		t.Errorf("String() after SetUppercase = %s, want %s", s, testUUID)
+	}
+	in.String(NameSpaceDNS)
+	in.String(NameSpaceURL)
+	if n := in.Len(); n != 2 {
+		t.Errorf("Len() = %d, want 2", n)
+	}
+}
@@ -111,3 +111,63 @@ func BenchmarkUUID_UnmarshalJSON(b *testing.B) {
 		}
 	}
 }
+
+func TestSetTextStyle(t *testing.T) {
+	defer SetTextStyle(StyleCanonical)
+	for style, want := range map[Style]string{
+		StyleCanonical: `"f47ac10b-58cc-0372-8567-0e02b2c3d479"`,
+		StyleBraced:    `"{f47ac10b-58cc-0372-8567-0e02b2c3d479}"`,
+		StyleParens:    `"(f47ac10b-58cc-0372-8567-0e02b2c3d479)"`,
+		StyleURN:       `"urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d479"`,
+		StyleHex:       `"f47ac10b58cc037285670e02b2c3d479"`,
+	} {
+		SetTextStyle(style)
+		data, err := json.Marshal(testUUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Errorf("style %d: got %s, want %s", style, data, want)
+		}
+		var got UUID
+		if err := json.Unmarshal(data, &got); err != nil || got != testUUID {
+			t.Errorf("style %d: round trip got %s, %v", style, got, err)
+		}
+	}
+	if s := testUUID.FormatBraced(); s != "{f47ac10b-58cc-0372-8567-0e02b2c3d479}" {
+		t.Errorf("FormatBraced() = %s", s)
+	}
+}
+
+func TestSetUppercase(t *testing.T) {
+	defer Restore(Snapshot())
+	SetUppercase(true)
+	if s := testUUID.String(); s != "F47AC10B-58CC-0372-8567-0E02B2C3D479" {
+		t.Errorf("String() = %s", s)
+	}
+	if s := testUUID.URN(); s != "urn:uuid:F47AC10B-58CC-0372-8567-0E02B2C3D479" {
+		t.Errorf("URN() = %s", s)
+	}
+	if u := testUUID.URNValue(); u.String() != testUUID.URN() {
+		t.Errorf("URNValue() = %s, want %s", u.String(), testUUID.URN())
+	}
+	for style, want := range map[Style]string{
+		StyleCanonical: `"F47AC10B-58CC-0372-8567-0E02B2C3D479"`,
+		StyleBraced:    `"{F47AC10B-58CC-0372-8567-0E02B2C3D479}"`,
+		StyleURN:       `"urn:uuid:F47AC10B-58CC-0372-8567-0E02B2C3D479"`,
+	} {
+		SetTextStyle(style)
+		data, _ := json.Marshal(testUUID)
+		if string(data) != want {
+			t.Errorf("style %d: got %s, want %s", style, data, want)
+		}
+		var got UUID
+		if err := json.Unmarshal(data, &got); err != nil || got != testUUID {
+			t.Errorf("style %d: round trip got %s, %v", style, got, err)
+		}
+	}
+	SetUppercase(false)
+	if s := testUUID.String(); s != "f47ac10b-58cc-0372-8567-0e02b2c3d479" {
+		t.Errorf("String() after SetUppercase(false) = %s", s)
+	}
+}
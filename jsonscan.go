@@ -0,0 +1,215 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A JSONScanner reads a JSON document of any size as a stream and finds the
+// strings shaped like UUIDs, with their paths in the document, for data
+// quality audits of exports holding millions of IDs:
+//
+//	s := uuid.NewJSONScanner(f)
+//	for s.Scan() {
+//		if err := s.Invalid(); err != nil {
+//			fmt.Printf("%s: %q: %v\n", s.Path(), s.Text(), err)
+//		}
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+//
+// A string is shaped like a UUID if it has hyphens where the standard form
+// does, with or without the braces or the URN prefix accepted by Parse.
+// Shaped strings that Parse rejects, such as ones with characters other than
+// hex digits, are reported with a non-nil Invalid error.  Object keys are not
+// scanned.
+type JSONScanner struct {
+	d     *json.Decoder
+	stack []jsonFrame
+	path  string
+	text  string
+	uuid  UUID
+	inval error
+	err   error
+}
+
+// A jsonFrame is an object or array being scanned.
+type jsonFrame struct {
+	array     bool
+	index     int    // index of the current element of an array
+	key       string // key of the current member of an object
+	expectKey bool
+}
+
+// NewJSONScanner returns a JSONScanner reading a JSON document from r.
+func NewJSONScanner(r io.Reader) *JSONScanner {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	return &JSONScanner{d: d}
+}
+
+// Scan advances the JSONScanner to the next string shaped like a UUID, which
+// is then available through the Path, Text, UUID and Invalid methods.  It
+// returns false when the scan stops, either by reaching the end of the
+// document or an error.
+func (s *JSONScanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for {
+		tok, err := s.d.Token()
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			} else if len(s.stack) > 0 {
+				s.err = io.ErrUnexpectedEOF
+			} else {
+				s.err = io.EOF
+			}
+			return false
+		}
+		var top *jsonFrame
+		if n := len(s.stack); n > 0 {
+			top = &s.stack[n-1]
+		}
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{':
+				s.stack = append(s.stack, jsonFrame{expectKey: true})
+			case '[':
+				s.stack = append(s.stack, jsonFrame{array: true})
+			default:
+				s.stack = s.stack[:len(s.stack)-1]
+				s.next()
+			}
+		case string:
+			if top != nil && !top.array && top.expectKey {
+				top.key = tok
+				top.expectKey = false
+				continue
+			}
+			found := uuidShaped(tok)
+			if found {
+				s.path = s.currentPath()
+				s.text = tok
+				s.uuid, s.inval = Parse(tok)
+			}
+			s.next()
+			if found {
+				return true
+			}
+		default:
+			s.next()
+		}
+	}
+}
+
+// next advances the innermost object or array to its next member or element.
+func (s *JSONScanner) next() {
+	if n := len(s.stack); n > 0 {
+		top := &s.stack[n-1]
+		if top.array {
+			top.index++
+		} else {
+			top.expectKey = true
+		}
+	}
+}
+
+// currentPath returns the path of the current value, such as
+// $.orders[3].id, with keys that are not identifiers quoted, as in
+// $["order id"].
+func (s *JSONScanner) currentPath() string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for _, f := range s.stack {
+		switch {
+		case f.array:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(f.index))
+			b.WriteByte(']')
+		case isIdentifier(f.key):
+			b.WriteByte('.')
+			b.WriteString(f.key)
+		default:
+			b.WriteByte('[')
+			b.WriteString(strconv.Quote(f.key))
+			b.WriteByte(']')
+		}
+	}
+	return b.String()
+}
+
+// Path returns the path in the document of the most recent string found by
+// Scan, such as $.orders[3].id.
+func (s *JSONScanner) Path() string {
+	return s.path
+}
+
+// Text returns the most recent string found by Scan.
+func (s *JSONScanner) Text() string {
+	return s.text
+}
+
+// UUID returns the UUID of the most recent string found by Scan, or Nil if it
+// is invalid.
+func (s *JSONScanner) UUID() UUID {
+	return s.uuid
+}
+
+// Invalid returns the error of Parse for the most recent string found by
+// Scan, or nil if it is a valid UUID.
+func (s *JSONScanner) Invalid() error {
+	return s.inval
+}
+
+// Err returns the first error encountered by the JSONScanner, such as a
+// syntax error of the document, or nil if the end of the document was
+// reached.
+func (s *JSONScanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
+
+// uuidShaped reports whether s has the hyphens of the standard form of a
+// UUID, optionally within braces or after a URN prefix.
+func uuidShaped(s string) bool {
+	switch len(s) {
+	case 36 + 9:
+		if !strings.EqualFold(s[:9], "urn:uuid:") {
+			return false
+		}
+		s = s[9:]
+	case 36 + 2:
+		if s[0] != '{' || s[37] != '}' {
+			return false
+		}
+		s = s[1:37]
+	case 36:
+	default:
+		return false
+	}
+	return s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-'
+}
+
+// isIdentifier reports whether s is a non-empty sequence of ASCII letters,
+// digits and underscores not starting with a digit.
+func isIdentifier(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !(i > 0 && '0' <= c && c <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONScanner(t *testing.T) {
+	const doc = `{
+		"id": "f47ac10b-58cc-0372-8567-0e02b2c3d479",
+		"name": "not a uuid",
+		"count": 3,
+		"orders": [
+			{"id": "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", "tags": [null, true]},
+			{"id": "6ba7b811-9dad-11d1-80b4-00c04fd4zzzz"},
+			"urn:uuid:6ba7b812-9dad-11d1-80b4-00c04fd430c8"
+		],
+		"6ba7b814-9dad-11d1-80b4-00c04fd430c8": {"parent id": "6ba7b814-9dad-11d1-80b4-00c04fd430c8"},
+		"empty": {},
+		"last": "6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	}`
+	type match struct {
+		path, text string
+		valid      bool
+	}
+	var got []match
+	s := NewJSONScanner(strings.NewReader(doc))
+	for s.Scan() {
+		got = append(got, match{s.Path(), s.Text(), s.Invalid() == nil})
+		if s.Invalid() == nil && s.UUID().String() != strings.Trim(strings.TrimPrefix(s.Text(), "urn:uuid:"), "{}") {
+			t.Errorf("UUID() = %s for %q", s.UUID(), s.Text())
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	want := []match{
+		{"$.id", "f47ac10b-58cc-0372-8567-0e02b2c3d479", true},
+		{"$.orders[0].id", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", true},
+		{"$.orders[1].id", "6ba7b811-9dad-11d1-80b4-00c04fd4zzzz", false},
+		{"$.orders[2]", "urn:uuid:6ba7b812-9dad-11d1-80b4-00c04fd430c8", true},
+		{`$["6ba7b814-9dad-11d1-80b4-00c04fd430c8"]["parent id"]`, "6ba7b814-9dad-11d1-80b4-00c04fd430c8", true},
+		{"$.last", "6ba7b811-9dad-11d1-80b4-00c04fd430c8", true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got matches\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestJSONScannerErrors(t *testing.T) {
+	for _, doc := range []string{
+		`["f47ac10b-58cc-0372-8567-0e02b2c3d479", `,
+		`{"id": }`,
+	} {
+		s := NewJSONScanner(strings.NewReader(doc))
+		for s.Scan() {
+		}
+		if s.Err() == nil {
+			t.Errorf("no error for %s", doc)
+		}
+		if doc[0] == '[' && s.Err() != io.ErrUnexpectedEOF {
+			t.Errorf("got error %v, want %v", s.Err(), io.ErrUnexpectedEOF)
+		}
+	}
+	s := NewJSONScanner(strings.NewReader(`"f47ac10b-58cc-0372-8567-0e02b2c3d479"`))
+	if !s.Scan() || s.Path() != "$" || s.Scan() || s.Err() != nil {
+		t.Errorf("scanning a top level string: path %q, error %v", s.Path(), s.Err())
+	}
+}
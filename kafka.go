@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/binary"
+
+// PartitionKey returns the Kafka partition, out of partitions, of a record
+// keyed by uuid as chosen by the default partitioner of the Java producer
+// when the key is serialized by Kafka's UUIDSerializer, which writes the
+// string form of the UUID.  Records produced by Go and Java producers for the
+// same UUID thus land on the same partition.  PartitionKey panics if
+// partitions is not positive.
+func PartitionKey(uuid UUID, partitions int) int32 {
+	var buf [36]byte
+	encodeHex(buf[:], uuid)
+	return partition(buf[:], partitions)
+}
+
+// PartitionKeyBinary is like PartitionKey for keys serialized as the 16 bytes
+// of uuid.
+func PartitionKeyBinary(uuid UUID, partitions int) int32 {
+	return partition(uuid[:], partitions)
+}
+
+// partition returns the partition of key as Kafka's default partitioner.
+func partition(key []byte, partitions int) int32 {
+	if partitions <= 0 {
+		panic("uuid: number of partitions must be positive")
+	}
+	return int32(murmur2(key)&0x7fffffff) % int32(partitions)
+}
+
+// murmur2 returns the 32 bit MurmurHash2 of data with the seed used by Kafka.
+func murmur2(data []byte) uint32 {
+	const (
+		seed = 0x9747b28c
+		m    = 0x5bd1e995
+		r    = 24
+	)
+	h := uint32(seed) ^ uint32(len(data))
+	n := len(data) &^ 3
+	for i := 0; i < n; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+	switch len(data) & 3 {
+	case 3:
+		h ^= uint32(data[n+2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[n+1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[n])
+		h *= m
+	}
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return h
+}
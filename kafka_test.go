@@ -0,0 +1,42 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestMurmur2(t *testing.T) {
+	// Test vectors of org.apache.kafka.common.utils.UtilsTest.
+	for s, want := range map[string]int32{
+		"21":                         -973932308,
+		"foobar":                     -790332482,
+		"a-little-bit-long-string":   -985981536,
+		"a-little-bit-longer-string": -1486304829,
+		"lkjh234lh9fiuh90y23oiuhsafujhadof229phr9h19h89h8": -58897971,
+		"abc": 479470107,
+	} {
+		if got := int32(murmur2([]byte(s))); got != want {
+			t.Errorf("murmur2(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	uuid := MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	h := int32(murmur2([]byte(uuid.String())) & 0x7fffffff)
+	for _, n := range []int{1, 3, 12, 1000} {
+		if got := PartitionKey(uuid, n); got != h%int32(n) {
+			t.Errorf("PartitionKey(%d) = %d, want %d", n, got, h%int32(n))
+		}
+		if got := PartitionKeyBinary(uuid, n); got < 0 || got >= int32(n) {
+			t.Errorf("PartitionKeyBinary(%d) = %d, out of range", n, got)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("PartitionKey(0) did not panic")
+		}
+	}()
+	PartitionKey(uuid, 0)
+}
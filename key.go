@@ -0,0 +1,33 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// Key returns prefix followed by the 16 bytes of uuid, a compact binary key
+// for caches such as Redis, in place of a 36 byte string:
+//
+//	rdb.Get(ctx, string(id.Key("session:")))
+//
+// Keys with the same prefix compare, byte by byte, in the order of their
+// UUIDs, which is the time order of Version 6 and Version 7 UUIDs, so
+// lexicographic range queries such as ZRANGEBYLEX return the keys of a range
+// of UUIDs (see V7Bounds).  Keys with prefixes of different lengths do not
+// compare meaningfully.
+func (uuid UUID) Key(prefix string) []byte {
+	key := make([]byte, len(prefix)+16)
+	copy(key, prefix)
+	copy(key[len(prefix):], uuid[:])
+	return key
+}
+
+// SplitKey returns the prefix and the UUID of key, a key returned by Key.  An
+// error is returned if key is shorter than 16 bytes.
+func SplitKey(key []byte) (prefix string, uuid UUID, err error) {
+	n := len(key) - 16
+	if n < 0 {
+		return "", Nil, invalidLengthError{len(key)}
+	}
+	copy(uuid[:], key[n:])
+	return string(key[:n]), uuid, nil
+}
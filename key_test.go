@@ -0,0 +1,34 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	key := testUUID.Key("session:")
+	if want := append([]byte("session:"), testUUID[:]...); !bytes.Equal(key, want) {
+		t.Errorf("Key() = %q, want %q", key, want)
+	}
+	prefix, u, err := SplitKey(key)
+	if prefix != "session:" || u != testUUID || err != nil {
+		t.Errorf("SplitKey(%q) = %q, %s, %v", key, prefix, u, err)
+	}
+	if prefix, u, err := SplitKey(testUUID[:]); prefix != "" || u != testUUID || err != nil {
+		t.Errorf("SplitKey of a UUID = %q, %s, %v", prefix, u, err)
+	}
+	if _, _, err := SplitKey(key[:15]); !IsInvalidLengthError(err) {
+		t.Errorf("SplitKey of 15 bytes got error %v", err)
+	}
+
+	// Keys sort in the order of their UUIDs.
+	u1 := Must(NewV7())
+	u2 := Must(NewV7())
+	if bytes.Compare(u1.Key("k"), u2.Key("k")) >= 0 {
+		t.Errorf("key of %s not before key of %s", u1, u2)
+	}
+}
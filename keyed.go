@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+)
+
+// NewKeyed returns a Version 8 UUID derived from the HMAC-SHA256 of name keyed
+// with key.  Unlike NewSHA1, the UUID of a name, such as an email address,
+// cannot be computed, or the name guessed from the UUID, without the key, so
+// keyed UUIDs may be used as stable pseudonyms of personal data.  Use a
+// KeyRing to rotate keys.
+func NewKeyed(key, name []byte) UUID {
+	h := hmac.New(sha256.New, key)
+	h.Write(name) //nolint:errcheck
+	return fromSum(h.Sum(nil))
+}
+
+// A KeyRing holds the generations of the key of keyed UUIDs: the current key,
+// used to generate new UUIDs, and the previous keys, still accepted by Verify
+// until the UUIDs derived from them are migrated.
+//
+// A KeyRing is safe for concurrent use by multiple goroutines.
+type KeyRing struct {
+	keys [][]byte // current key first
+}
+
+// NewKeyRing returns a KeyRing with the current key and the previous keys,
+// from the most to the least recent.  The keys are copied.
+func NewKeyRing(current []byte, previous ...[]byte) *KeyRing {
+	k := &KeyRing{keys: make([][]byte, 0, 1+len(previous))}
+	for _, key := range append([][]byte{current}, previous...) {
+		k.keys = append(k.keys, append([]byte(nil), key...))
+	}
+	return k
+}
+
+// New returns the keyed UUID of name with the current key of k.
+func (k *KeyRing) New(name []byte) UUID {
+	return NewKeyed(k.keys[0], name)
+}
+
+// All returns the keyed UUIDs of name with each key of k, starting with the
+// current key, for example to look up a pseudonym generated with any of the
+// keys.
+func (k *KeyRing) All(name []byte) []UUID {
+	uuids := make([]UUID, len(k.keys))
+	for i, key := range k.keys {
+		uuids[i] = NewKeyed(key, name)
+	}
+	return uuids
+}
+
+// Verify reports whether uuid is the keyed UUID of name with one of the keys
+// of k, and returns the generation of that key: 0 for the current key, 1 for
+// the most recent previous key and so on.  The UUIDs are compared in constant
+// time.
+func (k *KeyRing) Verify(uuid UUID, name []byte) (generation int, ok bool) {
+	for i, key := range k.keys {
+		want := NewKeyed(key, name)
+		if hmac.Equal(uuid[:], want[:]) {
+			return i, true
+		}
+	}
+	return -1, false
+}
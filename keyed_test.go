@@ -0,0 +1,57 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestNewKeyed(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	email := []byte("gopher@example.com")
+	u := NewKeyed(key, email)
+	if u.Version() != 8 || u.Variant() != RFC4122 {
+		t.Errorf("NewKeyed returned %s (version %s, variant %s)", u, u.Version(), u.Variant())
+	}
+	if again := NewKeyed(key, email); again != u {
+		t.Errorf("NewKeyed is not deterministic: %s != %s", again, u)
+	}
+	if other := NewKeyed([]byte("another key"), email); other == u {
+		t.Errorf("NewKeyed returned %s for different keys", other)
+	}
+	if other := NewKeyed(key, []byte("other@example.com")); other == u {
+		t.Errorf("NewKeyed returned %s for different names", other)
+	}
+}
+
+func TestKeyRing(t *testing.T) {
+	k1, k2, k3 := []byte("key one"), []byte("key two"), []byte("key three")
+	email := []byte("gopher@example.com")
+	old := NewKeyRing(k2, k1)
+	ring := NewKeyRing(k3, k2, k1)
+	k3[0] = 'K' // the keys are copied
+
+	u := ring.New(email)
+	if want := NewKeyed([]byte("key three"), email); u != want {
+		t.Errorf("New returned %s, want %s", u, want)
+	}
+	for _, tt := range []struct {
+		uuid UUID
+		gen  int
+		ok   bool
+	}{
+		{u, 0, true},
+		{old.New(email), 1, true},
+		{NewKeyed(k1, email), 2, true},
+		{NewKeyed([]byte("unknown"), email), -1, false},
+		{ring.New([]byte("other@example.com")), -1, false},
+	} {
+		if gen, ok := ring.Verify(tt.uuid, email); gen != tt.gen || ok != tt.ok {
+			t.Errorf("Verify(%s) = %d, %t, want %d, %t", tt.uuid, gen, ok, tt.gen, tt.ok)
+		}
+	}
+	all := ring.All(email)
+	if len(all) != 3 || all[0] != u || all[1] != old.New(email) {
+		t.Errorf("All returned %v", all)
+	}
+}
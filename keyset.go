@@ -0,0 +1,104 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"strconv"
+	"time"
+)
+
+// A Keyset selects a page of rows of a table keyed by UUIDs, for keyset
+// pagination: the at most Limit rows whose key compares to Bound with Op, in
+// Order.  Op is one of ">", ">=", "<" and "<=", and Order is "ASC" or "DESC".
+//
+// For tables keyed by Version 7 UUIDs, KeysetSince and KeysetUntil select the
+// first page at a time, and Next the following pages:
+//
+//	k := uuid.KeysetUntil(time.Now(), 50)
+//	for {
+//		rows, err := db.Query("SELECT id, ... FROM events WHERE "+k.Clause("id", "$1"), k.Bound)
+//		...
+//		if n < k.Limit {
+//			break
+//		}
+//		k = k.Next(last)
+//	}
+type Keyset struct {
+	Bound UUID
+	Op    string
+	Order string
+	Limit int
+}
+
+// KeysetAfter returns the Keyset of the limit rows following the row keyed by
+// u, in ascending order.
+func KeysetAfter(u UUID, limit int) Keyset {
+	return Keyset{Bound: u, Op: ">", Order: "ASC", Limit: limit}
+}
+
+// KeysetBefore returns the Keyset of the limit rows preceding the row keyed by
+// u, in descending order.
+func KeysetBefore(u UUID, limit int) Keyset {
+	return Keyset{Bound: u, Op: "<", Order: "DESC", Limit: limit}
+}
+
+// KeysetSince returns the Keyset of the limit oldest rows keyed by Version 7
+// UUIDs with a time at or after t, in ascending order.  As Version 7 UUIDs
+// only hold the time in milliseconds, the rows of the whole millisecond of t
+// are included, also those created before t in that millisecond.
+func KeysetSince(t time.Time, limit int) Keyset {
+	min, _ := V7Bounds(t, t)
+	return Keyset{Bound: min, Op: ">=", Order: "ASC", Limit: limit}
+}
+
+// KeysetUntil returns the Keyset of the limit newest rows keyed by Version 7
+// UUIDs with a time at or before t, in descending order.  As for KeysetSince,
+// the rows of the whole millisecond of t are included.
+func KeysetUntil(t time.Time, limit int) Keyset {
+	_, max := V7Bounds(t, t)
+	return Keyset{Bound: max, Op: "<=", Order: "DESC", Limit: limit}
+}
+
+// Next returns the Keyset of the page following that of k, in the same order,
+// given the key of the last row of the page of k.  The bound of the next page
+// is exclusive, so that rows are neither repeated nor skipped, even when
+// many rows share the millisecond of last.
+func (k Keyset) Next(last UUID) Keyset {
+	if k.Order == "DESC" {
+		return KeysetBefore(last, k.Limit)
+	}
+	return KeysetAfter(last, k.Limit)
+}
+
+// Contains reports whether a row keyed by u is selected by k, regardless of
+// Limit.
+func (k Keyset) Contains(u UUID) bool {
+	c := Compare(u, k.Bound)
+	switch k.Op {
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	}
+	return false
+}
+
+// Clause returns the SQL of k for the key column, with placeholder for
+// Bound, such as
+//
+//	id > $1 ORDER BY id ASC LIMIT 50
+//
+// The column is not quoted.  The LIMIT is omitted if Limit is not positive.
+func (k Keyset) Clause(column, placeholder string) string {
+	s := column + " " + k.Op + " " + placeholder + " ORDER BY " + column + " " + k.Order
+	if k.Limit > 0 {
+		s += " LIMIT " + strconv.Itoa(k.Limit)
+	}
+	return s
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+// page returns the keys of ids, sorted, selected by k.
+func page(ids []UUID, k Keyset) []UUID {
+	var p []UUID
+	for _, u := range ids {
+		if k.Contains(u) {
+			p = append(p, u)
+		}
+	}
+	sort.Slice(p, func(i, j int) bool {
+		if k.Order == "DESC" {
+			return Compare(p[i], p[j]) > 0
+		}
+		return Compare(p[i], p[j]) < 0
+	})
+	if len(p) > k.Limit {
+		p = p[:k.Limit]
+	}
+	return p
+}
+
+func TestKeyset(t *testing.T) {
+	start := time.Date(2024, 10, 15, 9, 32, 23, 500000, time.UTC)
+	g, _ := NewGenerator()
+	var ids []UUID
+	// Several UUIDs in each millisecond, to exercise page ends within a
+	// millisecond.
+	for i := 0; i < 50; i++ {
+		at := start.Add(time.Duration(i/7) * time.Millisecond)
+		u := Must(g.NewRandom())
+		putV7Time(u[:], TimeToUnixMilli(at), int64(i))
+		u[8] = u[8]&0x3f | 0x80
+		ids = append(ids, u)
+	}
+	sorted := append([]UUID(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return Compare(sorted[i], sorted[j]) < 0 })
+
+	for _, tt := range []struct {
+		name  string
+		first Keyset
+		want  []UUID
+	}{
+		{"since", KeysetSince(start.Add(2*time.Millisecond+time.Microsecond), 4), sorted[14:]},
+		{"until", KeysetUntil(start.Add(3*time.Millisecond), 4), sorted[:28]},
+	} {
+		var got []UUID
+		k := tt.first
+		for n := 0; n < 100; n++ {
+			p := page(ids, k)
+			got = append(got, p...)
+			if len(p) < k.Limit {
+				break
+			}
+			k = k.Next(p[len(p)-1])
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got %d UUIDs, want %d", tt.name, len(got), len(tt.want))
+		}
+		for i := range got {
+			j := i
+			if tt.first.Order == "DESC" {
+				j = len(got) - 1 - i
+			}
+			if got[i] != tt.want[j] {
+				t.Errorf("%s: UUID #%d is %s, want %s", tt.name, i, got[i], tt.want[j])
+			}
+		}
+	}
+}
+
+func TestKeysetClause(t *testing.T) {
+	for _, tt := range []struct {
+		k    Keyset
+		want string
+	}{
+		{KeysetAfter(Nil, 50), "id > $1 ORDER BY id ASC LIMIT 50"},
+		{KeysetBefore(Nil, 10), "id < $1 ORDER BY id DESC LIMIT 10"},
+		{KeysetSince(time.Now(), 0), "id >= $1 ORDER BY id ASC"},
+		{KeysetUntil(time.Now(), 5), "id <= $1 ORDER BY id DESC LIMIT 5"},
+	} {
+		if s := tt.k.Clause("id", "$1"); s != tt.want {
+			t.Errorf("Clause() = %q, want %q", s, tt.want)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+// LaxUUID is a UUID whose UnmarshalJSON tolerates the sloppy encodings of some
+// third-party payloads, while UUID stays strict:
+//
+//   - a JSON number, such as 5765264937231155720, is the UUID with the value
+//     of the number as an unsigned 128 bit integer, as sent by systems storing
+//     UUIDs as big integers;
+//   - white space around the UUID inside a JSON string is ignored;
+//   - the URN prefix, like the hex digits, may be in upper case.
+//
+// A JSON string must otherwise hold a form accepted by Parse.  As is the
+// convention of encoding/json, a JSON null leaves the LaxUUID unchanged.
+// MarshalJSON emits the same JSON as UUID does.
+type LaxUUID UUID
+
+// String returns l in the standard form, as UUID.String does.
+func (l LaxUUID) String() string {
+	return UUID(l).String()
+}
+
+// MarshalJSON implements json.Marshaler as UUID does.
+func (l LaxUUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(UUID(l))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LaxUUID) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	var id UUID
+	var err error
+	if len(data) > 0 && data[0] >= '0' && data[0] <= '9' {
+		id, err = decodeDecimal(string(data))
+	} else {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		id, err = Parse(strings.TrimFunc(s, unicode.IsSpace))
+	}
+	if err != nil {
+		return err
+	}
+	*l = LaxUUID(id)
+	return nil
+}
+
+// decimalDigits is the number of decimal digits of Max.
+const decimalDigits = 39
+
+// decodeDecimal decodes s, an unsigned 128 bit integer in decimal.
+func decodeDecimal(s string) (UUID, error) {
+	if len(s) > decimalDigits {
+		return Nil, ErrInvalidUUIDFormat
+	}
+	return DecodeAlphabet(strings.Repeat("0", decimalDigits-len(s))+s, "0123456789")
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLaxUUIDUnmarshalJSON(t *testing.T) {
+	u := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	for _, tt := range []struct {
+		in   string
+		want UUID
+	}{
+		{`"f47ac10b-58cc-4372-a567-0e02b2c3d479"`, u},
+		{`" F47AC10B-58CC-4372-A567-0E02B2C3D479\n"`, u},
+		{`"URN:UUID:f47ac10b-58cc-4372-a567-0e02b2c3d479"`, u},
+		{` "f47ac10b58cc4372a5670e02b2c3d479" `, u},
+		{`324969006592305634633390616021200786553`, u},
+		{`0`, Nil},
+		{`340282366920938463463374607431768211455`, Max},
+	} {
+		var l LaxUUID
+		if err := json.Unmarshal([]byte(tt.in), &l); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+		} else if UUID(l) != tt.want {
+			t.Errorf("Unmarshal(%s) = %s, want %s", tt.in, l, tt.want)
+		}
+	}
+	for _, in := range []string{
+		`340282366920938463463374607431768211456`,
+		`1.5`,
+		`-1`,
+		`1e3`,
+		`"f47ac10b-58cc-4372-a567-0e02b2c3d47"`,
+		`true`,
+	} {
+		var l LaxUUID
+		if err := json.Unmarshal([]byte(in), &l); err == nil {
+			t.Errorf("Unmarshal(%s) = %s, want an error", in, l)
+		}
+	}
+
+	// The core type stays strict.
+	var strict UUID
+	if err := json.Unmarshal([]byte(`" f47ac10b-58cc-4372-a567-0e02b2c3d479"`), &strict); err == nil {
+		t.Errorf("UUID accepted white space")
+	}
+
+	l := LaxUUID(u)
+	if err := json.Unmarshal([]byte(`null`), &l); err != nil || UUID(l) != u {
+		t.Errorf("Unmarshal(null) = %s, %v, want %s unchanged", l, err, u)
+	}
+	out, _ := json.Marshal(l)
+	if want := `"` + u.String() + `"`; string(out) != want {
+		t.Errorf("Marshal = %s, want %s", out, want)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// ErrLockFileUnsupported is returned by NewGenerator when WithLockFile is
+// used on a platform without file locks.
+var ErrLockFileUnsupported = errors.New("lock files are not supported on this platform")
+
+// WithLockFile makes the Generator share its Version 7 clock state with all
+// the Generators created with the same path, in this and other processes of
+// the host, so that CLI tools and cron jobs writing into the same ordered
+// table generate a single increasing sequence of Version 7 UUIDs.
+//
+// The state is kept in the file named path, created if needed, which is
+// locked with flock(2) during each generation.  NewGenerator returns an error
+// if the file cannot be opened, and ErrLockFileUnsupported on platforms other
+// than Linux, macOS and the BSDs.  NewV7 and NewV7Batch return the errors of
+// locking, reading and writing the file, without UUIDs.  The file stays open
+// until the Generator is closed with Close.
+func WithLockFile(path string) GeneratorOption {
+	return func(g *Generator) {
+		g.lockPath = path
+	}
+}
+
+// A lockFile holds the Version 7 clock state shared by Generators, in the
+// format of lastV7time, as 8 bytes in big endian order.
+type lockFile struct {
+	f *os.File
+}
+
+func openLockFile(path string) (*lockFile, error) {
+	if !flockSupported {
+		return nil, ErrLockFileUnsupported
+	}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &lockFile{f}, nil
+}
+
+// lock locks l and returns the state it holds.
+func (l *lockFile) lock() (int64, error) {
+	if err := flock(l.f); err != nil {
+		return 0, err
+	}
+	var b [8]byte
+	if _, err := l.f.ReadAt(b[:], 0); err != nil && err != io.EOF {
+		funlock(l.f) //nolint:errcheck
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(b[:])), nil
+}
+
+// unlock saves state in l and unlocks l.
+func (l *lockFile) unlock(state int64) error {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(state))
+	_, err := l.f.WriteAt(b[:], 0)
+	if uerr := funlock(l.f); err == nil {
+		err = uerr
+	}
+	return err
+}
+
+// close closes the file of l.
+func (l *lockFile) close() error {
+	return l.f.Close()
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package uuid
+
+import "os"
+
+const flockSupported = false
+
+func flock(f *os.File) error   { return ErrLockFileUnsupported }
+func funlock(f *os.File) error { return ErrLockFileUnsupported }
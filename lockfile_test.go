@@ -0,0 +1,88 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uuid.lock")
+	if !flockSupported {
+		if _, err := NewGenerator(WithLockFile(path)); err != ErrLockFileUnsupported {
+			t.Fatalf("NewGenerator returned %v, want %v", err, ErrLockFileUnsupported)
+		}
+		t.Skip("no file locks")
+	}
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	// Each Generator opens the file on its own, as separate processes do.
+	var gens []*Generator
+	for i := 0; i < 4; i++ {
+		g, err := NewGenerator(WithLockFile(path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer g.Close()
+		gens = append(gens, g)
+	}
+	var mu sync.Mutex
+	var all []UUID
+	var wg sync.WaitGroup
+	for _, g := range gens {
+		wg.Add(1)
+		go func(g *Generator) {
+			defer wg.Done()
+			var ids []UUID
+			for i := 0; i < 500; i++ {
+				if i%100 == 99 {
+					b, err := g.NewV7Batch(10)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					ids = append(ids, b...)
+					continue
+				}
+				u, err := g.NewV7()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				ids = append(ids, u)
+			}
+			mu.Lock()
+			all = append(all, ids...)
+			mu.Unlock()
+		}(g)
+	}
+	wg.Wait()
+
+	// With a frozen clock, only the shared counter orders the UUIDs: they
+	// are all distinct in their time and counter.
+	sort.Slice(all, func(i, j int) bool { return Compare(all[i], all[j]) < 0 })
+	for i := 1; i < len(all); i++ {
+		if bytes.Equal(all[i-1][:8], all[i][:8]) {
+			t.Fatalf("UUIDs %s and %s share their time and counter", all[i-1], all[i])
+		}
+	}
+
+	g, err := NewGenerator(WithLockFile(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u := Must(g.NewV7()); Compare(all[len(all)-1], u) >= 0 {
+		t.Errorf("UUID of a new Generator %s not greater than %s", u, all[len(all)-1])
+	}
+}
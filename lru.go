@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "container/list"
+
+// An lru is a cache holding up to size values, evicting the least recently
+// used value when full.  An lru is not safe for concurrent use.
+type lru struct {
+	size  int
+	ll    *list.List // of *lruEntry, most recently used first
+	items map[interface{}]*list.Element
+	evict func(value interface{}) // if not nil, called with evicted values
+}
+
+type lruEntry struct {
+	key, value interface{}
+}
+
+// newLRU returns an lru holding up to size values.  A size of less than 1 is
+// taken as 1.
+func newLRU(size int) *lru {
+	if size < 1 {
+		size = 1
+	}
+	return &lru{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[interface{}]*list.Element),
+	}
+}
+
+// get returns the value of key and marks it as most recently used.
+func (c *lru) get(key interface{}) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+// add sets the value of key, evicting the least recently used value if c is
+// full.
+func (c *lru) add(key, value interface{}) {
+	if e, ok := c.items[key]; ok {
+		e.Value.(*lruEntry).value = value
+		c.ll.MoveToFront(e)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&lruEntry{key, value})
+	if c.ll.Len() > c.size {
+		e := c.ll.Back()
+		c.ll.Remove(e)
+		delete(c.items, e.Value.(*lruEntry).key)
+		if c.evict != nil {
+			c.evict(e.Value.(*lruEntry).value)
+		}
+	}
+}
+
+// len returns the number of values in c.
+func (c *lru) len() int {
+	return c.ll.Len()
+}
@@ -6,11 +6,39 @@ package uuid
 
 import "fmt"
 
-// MarshalText implements encoding.TextMarshaler.
+// SetTextStyle sets the Style of the text returned by MarshalText, and thus
+// of UUIDs encoded by encoding/json and other encoders using
+// encoding.TextMarshaler, to s.  For example, SetTextStyle(StyleBraced) makes
+// MarshalText emit braces, as Windows registry tooling expects.  All styles
+// are accepted by UnmarshalText.  The default style is StyleCanonical.
+func SetTextStyle(s Style) {
+	updateConfig(func(c *config) { c.textStyle = s })
+}
+
+// SetUppercase sets whether String, URN, URNValue and MarshalText emit the
+// hex digits of UUIDs in upper case, for legacy systems, such as some
+// mainframe gateways, that require upper case identifiers.  Parsing is not
+// affected and accepts both cases.  The default is lower case, as recommended by RFC 9562.
+//
+// SetUppercase may be called concurrently with the formatting of UUIDs.
+func SetUppercase(on bool) {
+	updateConfig(func(c *config) { c.upper = on })
+}
+
+// MarshalText implements encoding.TextMarshaler.  The text is in the Style
+// set with SetTextStyle and in the case set with SetUppercase.
 func (uuid UUID) MarshalText() ([]byte, error) {
-	var js [36]byte
-	encodeHex(js[:], uuid)
-	return js[:], nil
+	c := loadConfig()
+	js := make([]byte, c.textStyle.size())
+	encodeStyle(js, uuid, c.textStyle)
+	if c.upper {
+		if c.textStyle == StyleURN {
+			upperHex(js[9:])
+		} else {
+			upperHex(js)
+		}
+	}
+	return js, nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -0,0 +1,71 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"fmt"
+	"sync"
+)
+
+type monotonicityError struct{ prev, uuid UUID }
+
+func (e monotonicityError) Error() string {
+	if e.uuid == e.prev {
+		return fmt.Sprintf("duplicate UUID %s", e.uuid)
+	}
+	return fmt.Sprintf("UUID %s is out of order after %s", e.uuid, e.prev)
+}
+
+func (e monotonicityError) Is(target error) bool {
+	_, ok := target.(monotonicityError)
+	return ok
+}
+
+// ErrNotMonotonic matches, using errors.Is, the errors returned by
+// MonotonicChecker.Observe for UUIDs that are not greater than a previously
+// observed UUID.
+var ErrNotMonotonic = monotonicityError{}
+
+// A MonotonicChecker verifies that a stream of Version 6 and Version 7 UUIDs
+// is strictly increasing, as the UUIDs of NewV6 and NewV7 are.  It may be used
+// in tests of exporters that must preserve the order of records, or in
+// production to detect clocks going backwards.  The Version 6 and Version 7
+// UUIDs of a stream are checked independently.
+//
+// A MonotonicChecker is safe for concurrent use by multiple goroutines.
+type MonotonicChecker struct {
+	mu     sync.Mutex
+	v6, v7 UUID // protected by mu, the greatest UUIDs observed
+}
+
+// NewMonotonicChecker returns a MonotonicChecker that has not observed any
+// UUIDs.
+func NewMonotonicChecker() *MonotonicChecker {
+	return &MonotonicChecker{}
+}
+
+// Observe returns an error matching ErrNotMonotonic if uuid is not greater
+// than the greatest UUID of the same version previously observed by c, and
+// ErrNoTimestamp if uuid is neither a Version 6 nor a Version 7 UUID.  UUIDs
+// for which an error is returned are otherwise ignored, so that a single
+// regressed UUID does not cause errors for the UUIDs that follow it.
+func (c *MonotonicChecker) Observe(uuid UUID) error {
+	var last *UUID
+	switch uuid.Version() {
+	case 6:
+		last = &c.v6
+	case 7:
+		last = &c.v7
+	default:
+		return ErrNoTimestamp
+	}
+	defer c.mu.Unlock()
+	c.mu.Lock()
+	if *last != Nil && Compare(uuid, *last) <= 0 {
+		return monotonicityError{*last, uuid}
+	}
+	*last = uuid
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMonotonicChecker(t *testing.T) {
+	c := NewMonotonicChecker()
+	var v7s []UUID
+	for i := 0; i < 3; i++ {
+		v7s = append(v7s, Must(NewV7()))
+	}
+	v6 := Must(NewV6())
+	for _, u := range []UUID{v7s[0], v6, v7s[1]} {
+		if err := c.Observe(u); err != nil {
+			t.Errorf("Observe(%s): %v", u, err)
+		}
+	}
+	for _, u := range []UUID{v7s[0], v7s[1], v6} {
+		if err := c.Observe(u); !errors.Is(err, ErrNotMonotonic) {
+			t.Errorf("Observe(%s) got error %v, want %v", u, err, ErrNotMonotonic)
+		}
+	}
+	// The regressed UUIDs are ignored.
+	if err := c.Observe(v7s[2]); err != nil {
+		t.Errorf("Observe(%s): %v", v7s[2], err)
+	}
+	if err := c.Observe(New()); err != ErrNoTimestamp {
+		t.Errorf("Observe of a Version 4 UUID got error %v, want %v", err, ErrNoTimestamp)
+	}
+	if err := c.Observe(v7s[1]); err == nil || err.Error() != "UUID "+v7s[1].String()+" is out of order after "+v7s[2].String() {
+		t.Errorf("got error %v", err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// An ErrorHandler receives the errors of the MustNew functions.
+type ErrorHandler func(err error)
+
+// SetErrorHandler registers h to receive the errors of MustNewRandom,
+// MustNewUUID, MustNewV6 and MustNewV7.  Library code can use the MustNew
+// functions to generate UUIDs without an error path of its own, while the
+// application decides how failures are reported, for example by logging them
+// or sending them on a channel.
+//
+// By default, and after SetErrorHandler(nil), errors are discarded.
+func SetErrorHandler(h ErrorHandler) {
+	updateConfig(func(c *config) { c.errorHandler = h })
+}
+
+// MustNewRandom is like NewRandom but does not return an error.  If NewRandom
+// fails the error is passed to the handler registered with SetErrorHandler,
+// if any, and Nil is returned.  Unlike Must, MustNewRandom never panics, so
+// that generating a UUID cannot take down the program.
+func MustNewRandom() UUID {
+	return handle(NewRandom())
+}
+
+// MustNewUUID is like NewUUID but handles errors as MustNewRandom does.
+func MustNewUUID() UUID {
+	return handle(NewUUID())
+}
+
+// MustNewV6 is like NewV6 but handles errors as MustNewRandom does.
+func MustNewV6() UUID {
+	return handle(NewV6())
+}
+
+// MustNewV7 is like NewV7 but handles errors as MustNewRandom does.
+func MustNewV7() UUID {
+	return handle(NewV7())
+}
+
+// handle returns uuid if err is nil.  Otherwise err is passed to the
+// registered ErrorHandler, if any, and Nil is returned.
+func handle(uuid UUID, err error) UUID {
+	if err == nil {
+		return uuid
+	}
+	if h := loadConfig().errorHandler; h != nil {
+		h(err)
+	}
+	return Nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMustNew(t *testing.T) {
+	for name, f := range map[string]func() UUID{
+		"MustNewRandom": MustNewRandom,
+		"MustNewUUID":   MustNewUUID,
+		"MustNewV6":     MustNewV6,
+		"MustNewV7":     MustNewV7,
+	} {
+		if uuid := f(); uuid == Nil {
+			t.Errorf("%s returned Nil", name)
+		}
+	}
+}
+
+func TestMustNewErrorHandler(t *testing.T) {
+	SetRand(strings.NewReader(""))
+	defer SetRand(nil)
+
+	errs := make(chan error, 1)
+	SetErrorHandler(func(err error) { errs <- err })
+	defer SetErrorHandler(nil)
+
+	if uuid := MustNewV7(); uuid != Nil {
+		t.Errorf("MustNewV7 returned %s, want Nil", uuid)
+	}
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Errorf("handler received nil error")
+		}
+	default:
+		t.Errorf("handler was not called")
+	}
+}
+
+func TestMustNewWithoutHandler(t *testing.T) {
+	SetRand(strings.NewReader(""))
+	defer SetRand(nil)
+	if uuid := MustNewRandom(); uuid != Nil {
+		t.Errorf("MustNewRandom returned %s, want Nil", uuid)
+	}
+}
+
+func TestHandle(t *testing.T) {
+	var got error
+	SetErrorHandler(func(err error) { got = err })
+	defer SetErrorHandler(nil)
+
+	want := errors.New("boom")
+	if uuid := handle(NameSpaceDNS, want); uuid != Nil || got != want {
+		t.Errorf("handle got (%s, %v), want (%s, %v)", uuid, got, Nil, want)
+	}
+	got = nil
+	if uuid := handle(NameSpaceDNS, nil); uuid != NameSpaceDNS || got != nil {
+		t.Errorf("handle got (%s, %v), want (%s, <nil>)", uuid, got, NameSpaceDNS)
+	}
+}
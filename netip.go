@@ -0,0 +1,35 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package uuid
+
+import (
+	"errors"
+	"net/netip"
+)
+
+// ErrNotIPv6 is returned by FromIPv6 for addresses that are not IPv6.
+var ErrNotIPv6 = errors.New("not an IPv6 address")
+
+// FromIPv6 returns the UUID with the 16 bytes of the IPv6 address addr, for
+// systems that key records by address or pass identifiers through address
+// shaped fields.  The UUID is not of any version and variant unless addr was
+// returned by ToIPv6.  IPv4 addresses must be mapped to IPv6 first, see
+// netip.AddrFrom16, otherwise ErrNotIPv6 is returned.  The zone of addr is
+// discarded.
+func FromIPv6(addr netip.Addr) (UUID, error) {
+	if !addr.Is6() {
+		return Nil, ErrNotIPv6
+	}
+	return UUID(addr.As16()), nil
+}
+
+// ToIPv6 returns the IPv6 address with the 16 bytes of uuid.  FromIPv6
+// returns uuid for the address.
+func (uuid UUID) ToIPv6() netip.Addr {
+	return netip.AddrFrom16(uuid)
+}
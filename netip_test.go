@@ -0,0 +1,36 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package uuid
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPv6(t *testing.T) {
+	uuid := MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	addr := uuid.ToIPv6()
+	if want := netip.MustParseAddr("f47a:c10b:58cc:4372:8567:e02:b2c3:d479"); addr != want {
+		t.Errorf("ToIPv6 = %v, want %v", addr, want)
+	}
+	got, err := FromIPv6(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uuid {
+		t.Errorf("FromIPv6 = %s, want %s", got, uuid)
+	}
+	if got, err := FromIPv6(netip.MustParseAddr("fe80::1%eth0")); err != nil || got != MustParse("fe800000-0000-0000-0000-000000000001") {
+		t.Errorf("FromIPv6 with zone = %s, %v", got, err)
+	}
+	for _, addr := range []netip.Addr{{}, netip.MustParseAddr("192.0.2.1")} {
+		if _, err := FromIPv6(addr); err != ErrNotIPv6 {
+			t.Errorf("FromIPv6(%v) got error %v, want %v", addr, err, ErrNotIPv6)
+		}
+	}
+}
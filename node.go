@@ -5,6 +5,8 @@
 package uuid
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"sync"
 )
 
@@ -88,3 +90,26 @@ func (uuid UUID) NodeID() []byte {
 	copy(node[:], uuid[10:])
 	return node[:]
 }
+
+// AnonymizeNode returns uuid with its Node ID replaced by a keyed hash
+// (HMAC-SHA256) of the Node ID, for scrubbing the hardware addresses of hosts
+// from stored version 1, 2 and 6 UUIDs.  All other fields are preserved, so
+// UUIDs of the same host remain distinct, and UUIDs of the same host and key
+// are given the same Node ID.  Following RFC 9562 section 6.10, the multicast
+// bit of the new Node ID is set so that it cannot collide with a hardware
+// address.  Keep key secret: the Node ID of a MAC address can be recovered by
+// anyone who knows the key by trying all addresses of a vendor.
+//
+// UUIDs of other versions are returned unchanged.
+func AnonymizeNode(uuid UUID, key []byte) UUID {
+	switch uuid.Version() {
+	case 1, 2, 6:
+	default:
+		return uuid
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(uuid[10:]) //nolint:errcheck
+	copy(uuid[10:], mac.Sum(nil))
+	uuid[10] |= 0x01 // multicast bit
+	return uuid
+}
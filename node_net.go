@@ -31,3 +31,24 @@ func getHardwareInterface(name string) (string, []byte) {
 	}
 	return "", nil
 }
+
+// listInterfaces returns the system's interfaces with hardware addresses of
+// at least 6 bytes.
+func listInterfaces() []hwInterface {
+	ifs, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	var list []hwInterface
+	for _, i := range ifs {
+		if len(i.HardwareAddr) >= 6 {
+			list = append(list, hwInterface{
+				name:     i.Name,
+				addr:     i.HardwareAddr,
+				up:       i.Flags&net.FlagUp != 0,
+				loopback: i.Flags&net.FlagLoopback != 0,
+			})
+		}
+	}
+	return list
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAnonymizeNode(t *testing.T) {
+	key := []byte("secret")
+	a := MustParse("7d444840-9dc0-11d1-b245-5ffdce74fad2")
+	b := MustParse("7d444841-9dc0-11d1-b245-5ffdce74fad2")
+	c := MustParse("7d444840-9dc0-11d1-b245-5ffdce74fad3")
+
+	aa, ab, ac := AnonymizeNode(a, key), AnonymizeNode(b, key), AnonymizeNode(c, key)
+	if !bytes.Equal(aa[:10], a[:10]) {
+		t.Errorf("AnonymizeNode changed fields other than the node: %s -> %s", a, aa)
+	}
+	if bytes.Equal(aa.NodeID(), a.NodeID()) {
+		t.Errorf("AnonymizeNode kept the node of %s", a)
+	}
+	if aa[10]&0x01 == 0 {
+		t.Errorf("AnonymizeNode did not set the multicast bit: %s", aa)
+	}
+	if !bytes.Equal(aa.NodeID(), ab.NodeID()) {
+		t.Errorf("same node anonymized differently: %s, %s", aa, ab)
+	}
+	if bytes.Equal(aa.NodeID(), ac.NodeID()) {
+		t.Errorf("different nodes anonymized alike: %s, %s", aa, ac)
+	}
+	if ak := AnonymizeNode(a, []byte("other")); ak == aa {
+		t.Errorf("different keys anonymized alike: %s", ak)
+	}
+
+	v6 := Must(NewV6())
+	if AnonymizeNode(v6, key) == v6 {
+		t.Errorf("version 6 UUID %s not anonymized", v6)
+	}
+	v4 := New()
+	if AnonymizeNode(v4, key) != v4 {
+		t.Errorf("version 4 UUID %s changed", v4)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNoNodeEnv is returned by EnvNodeProvider when neither POD_NAME nor
+	// HOSTNAME is set.
+	ErrNoNodeEnv = errors.New("neither POD_NAME nor HOSTNAME is set")
+
+	// ErrNoInterface is returned by InterfaceNodeProvider when no suitable
+	// interface is found and there is no StateStore to fall back to.
+	ErrNoInterface = errors.New("no suitable network interface")
+)
+
+// A NodeProvider provides the Node ID of Version 1 and 6 UUIDs.
+type NodeProvider interface {
+	// NodeID returns a 6 byte Node ID.
+	NodeID() ([]byte, error)
+}
+
+// SetNodeProvider sets the Node ID to the one returned by p.  NodeInterface
+// returns "provider" afterwards.  The error of p, if any, is returned and the
+// Node ID is not changed.
+func SetNodeProvider(p NodeProvider) error {
+	id, err := p.NodeID()
+	if err != nil {
+		return err
+	}
+	if len(id) < 6 {
+		return errors.New("NodeProvider returned a Node ID of less than 6 bytes")
+	}
+	defer nodeMu.Unlock()
+	nodeMu.Lock()
+	copy(nodeID[:], id)
+	ifname = "provider"
+	return nil
+}
+
+// EnvNodeProvider is a NodeProvider deriving the Node ID from the environment
+// of a container, where hardware addresses are synthetic and may be shared by
+// replicas.
+//
+// If POD_NAME, as set through the Kubernetes downward API, is the name of a
+// StatefulSet replica, such as "web-3", the upper 32 bits of the Node ID are a
+// hash of the StatefulSet name and the lower 16 bits are the ordinal, so the
+// Node IDs of the replicas of a StatefulSet with up to 65536 replicas are
+// unique and stable across restarts.  Otherwise the Node ID is a SHA-256 hash
+// of POD_NAME or, if unset, HOSTNAME.
+//
+// As required by RFC 9562 section 6.10 for Node IDs that are not hardware
+// addresses, the multicast bit of the Node ID is set.
+//
+// The zero value is ready to use.
+type EnvNodeProvider struct{}
+
+// NodeID implements NodeProvider.
+func (EnvNodeProvider) NodeID() ([]byte, error) {
+	if name := os.Getenv("POD_NAME"); name != "" {
+		if set, ordinal, ok := splitOrdinal(name); ok {
+			id := hashNode(set)
+			binary.BigEndian.PutUint16(id[4:], ordinal)
+			return id, nil
+		}
+		return hashNode(name), nil
+	}
+	if name := os.Getenv("HOSTNAME"); name != "" {
+		return hashNode(name), nil
+	}
+	return nil, ErrNoNodeEnv
+}
+
+// splitOrdinal splits the name of a StatefulSet replica, such as "web-3", into
+// the name of the StatefulSet and the ordinal.
+func splitOrdinal(name string) (set string, ordinal uint16, ok bool) {
+	i := strings.LastIndexByte(name, '-')
+	if i <= 0 {
+		return "", 0, false
+	}
+	n, err := strconv.ParseUint(name[i+1:], 10, 16)
+	if err != nil {
+		return "", 0, false
+	}
+	return name[:i], uint16(n), true
+}
+
+// hashNode returns a Node ID hashed from name with the multicast bit set.
+func hashNode(name string) []byte {
+	h := sha256.Sum256([]byte(name))
+	id := h[:6]
+	id[0] |= 0x01 // multicast bit
+	return id
+}
+
+// virtualPrefixes are the name prefixes of interfaces created by container
+// runtimes, bridges and tunnels.
+var virtualPrefixes = []string{
+	"veth", "docker", "br-", "virbr", "cni", "flannel", "cali", "weave",
+	"vxlan", "tun", "tap", "lxc", "kube", "cilium", "podman",
+}
+
+// hwInterface is a network interface with a hardware address.
+type hwInterface struct {
+	name     string
+	addr     []byte
+	up       bool
+	loopback bool
+}
+
+// virtual reports whether i looks like an interface of a container runtime,
+// a bridge or a tunnel.
+func (i hwInterface) virtual() bool {
+	for _, p := range virtualPrefixes {
+		if strings.HasPrefix(i.name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// physical reports whether i is up and has a universally administered
+// unicast hardware address, as physical network cards have.
+func (i hwInterface) physical() bool {
+	return i.up && !i.loopback && i.addr[0]&0x03 == 0
+}
+
+// InterfaceNodeProvider is a NodeProvider selecting the hardware address of a
+// network interface more carefully than SetNodeInterface, which uses the
+// first interface found.  Loopback interfaces are always skipped.
+//
+// The zero value uses the first interface found.
+type InterfaceNodeProvider struct {
+	// SkipVirtual skips the veth, docker and bridge interfaces of container
+	// runtimes as well as tunnels, whose addresses are synthetic and may be
+	// shared by several hosts.
+	SkipVirtual bool
+
+	// PreferPhysical prefers interfaces that are up and have a universally
+	// administered unicast address over all other interfaces.
+	PreferPhysical bool
+
+	// Store, if not nil, is used when no suitable interface is found: the
+	// Node ID saved in Store is returned, or, if there is none, a random
+	// Node ID is generated and saved, so it stays the same across
+	// restarts.
+	Store StateStore
+
+	// interfaces returns the candidate interfaces, listInterfaces if nil.
+	interfaces func() []hwInterface
+}
+
+// NodeID implements NodeProvider.
+func (p InterfaceNodeProvider) NodeID() ([]byte, error) {
+	list := p.interfaces
+	if list == nil {
+		list = listInterfaces
+	}
+	var candidates []hwInterface
+	for _, i := range list() {
+		if i.loopback || p.SkipVirtual && i.virtual() {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+	if p.PreferPhysical {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].physical() && !candidates[j].physical()
+		})
+	}
+	if len(candidates) > 0 {
+		return append([]byte(nil), candidates[0].addr[:6]...), nil
+	}
+	if p.Store == nil {
+		return nil, ErrNoInterface
+	}
+	return storedNodeID(p.Store)
+}
+
+// storedNodeID returns the Node ID saved in store, generating and saving a
+// random Node ID with the multicast bit set if there is none.
+func storedNodeID(store StateStore) ([]byte, error) {
+	s, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if len(s.NodeID) == 6 {
+		return s.NodeID, nil
+	}
+	id := make([]byte, 6)
+	if _, err := io.ReadFull(loadConfig().rand, id); err != nil {
+		return nil, err
+	}
+	id[0] |= 0x01 // multicast bit
+	s.NodeID = id
+	if err := store.Save(s); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnvNodeProvider(t *testing.T) {
+	t.Setenv("HOSTNAME", "")
+	t.Setenv("POD_NAME", "")
+	if _, err := (EnvNodeProvider{}).NodeID(); err != ErrNoNodeEnv {
+		t.Errorf("got error %v, want %v", err, ErrNoNodeEnv)
+	}
+
+	t.Setenv("HOSTNAME", "host-a")
+	host, err := EnvNodeProvider{}.NodeID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(host) != 6 || host[0]&0x01 == 0 {
+		t.Errorf("NodeID = %x, want 6 bytes with the multicast bit set", host)
+	}
+
+	t.Setenv("POD_NAME", "web-3")
+	web3, _ := EnvNodeProvider{}.NodeID()
+	t.Setenv("POD_NAME", "web-4")
+	web4, _ := EnvNodeProvider{}.NodeID()
+	if !bytes.Equal(web3[:4], web4[:4]) || web3[5] != 3 || web4[5] != 4 {
+		t.Errorf("replica Node IDs %x and %x, want a shared prefix and the ordinal", web3, web4)
+	}
+	if web3[0]&0x01 == 0 {
+		t.Errorf("NodeID = %x, want the multicast bit set", web3)
+	}
+	t.Setenv("POD_NAME", "web-x")
+	if id, _ := (EnvNodeProvider{}).NodeID(); bytes.Equal(id, host) || bytes.Equal(id, web3) {
+		t.Errorf("POD_NAME without ordinal gave Node ID %x", id)
+	}
+}
+
+func TestSetNodeProvider(t *testing.T) {
+	defer SetNodeInterface("")
+	t.Setenv("POD_NAME", "web-7")
+	if err := SetNodeProvider(EnvNodeProvider{}); err != nil {
+		t.Fatal(err)
+	}
+	want, _ := EnvNodeProvider{}.NodeID()
+	if got := NodeID(); !bytes.Equal(got, want) {
+		t.Errorf("NodeID() = %x, want %x", got, want)
+	}
+	if name := NodeInterface(); name != "provider" {
+		t.Errorf("NodeInterface() = %q, want provider", name)
+	}
+}
+
+func TestInterfaceNodeProvider(t *testing.T) {
+	mac := func(b0 byte, last byte) []byte { return []byte{b0, 0, 0, 0, 0, last} }
+	list := func() []hwInterface {
+		return []hwInterface{
+			{name: "lo", addr: mac(0, 0), up: true, loopback: true},
+			{name: "docker0", addr: mac(0x02, 1), up: true},
+			{name: "veth12ab", addr: mac(0x02, 2), up: true},
+			{name: "eth1", addr: mac(0x00, 3), up: false},
+			{name: "wlan0", addr: mac(0x02, 4), up: true},
+			{name: "eth0", addr: mac(0x00, 5), up: true},
+		}
+	}
+	for _, tt := range []struct {
+		p    InterfaceNodeProvider
+		want byte
+	}{
+		{InterfaceNodeProvider{interfaces: list}, 1},
+		{InterfaceNodeProvider{SkipVirtual: true, interfaces: list}, 3},
+		{InterfaceNodeProvider{PreferPhysical: true, interfaces: list}, 5},
+		{InterfaceNodeProvider{SkipVirtual: true, PreferPhysical: true, interfaces: list}, 5},
+	} {
+		id, err := tt.p.NodeID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id[5] != tt.want {
+			t.Errorf("%+v selected %x, want interface #%d", tt.p, id, tt.want)
+		}
+	}
+
+	none := func() []hwInterface { return list()[:3] }
+	if _, err := (InterfaceNodeProvider{SkipVirtual: true, interfaces: none}).NodeID(); err != ErrNoInterface {
+		t.Errorf("got error %v, want %v", err, ErrNoInterface)
+	}
+	store := FileStateStore{Path: filepath.Join(t.TempDir(), "state.json")}
+	p := InterfaceNodeProvider{SkipVirtual: true, Store: store, interfaces: none}
+	id1, err := p.NodeID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(id1) != 6 || id1[0]&0x01 == 0 {
+		t.Errorf("random NodeID = %x, want 6 bytes with the multicast bit set", id1)
+	}
+	if id2, _ := p.NodeID(); !bytes.Equal(id1, id2) {
+		t.Errorf("persisted NodeID changed from %x to %x", id1, id2)
+	}
+}
+
+func TestFileStateStore(t *testing.T) {
+	store := FileStateStore{Path: filepath.Join(t.TempDir(), "state.json")}
+	s, err := store.Load()
+	if err != nil || s.NodeID != nil {
+		t.Errorf("Load of missing file = %+v, %v, want zero State", s, err)
+	}
+	want := State{NodeID: []byte{1, 2, 3, 4, 5, 6}}
+	if err := store.Save(want); err != nil {
+		t.Fatal(err)
+	}
+	if s, err := store.Load(); err != nil || !bytes.Equal(s.NodeID, want.NodeID) {
+		t.Errorf("Load = %+v, %v, want %+v", s, err, want)
+	}
+}
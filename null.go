@@ -26,6 +26,10 @@ var jsonNull = []byte("null")
 //     // NULL value
 //  }
 //
+// A NullUUID that is not valid is encoded as null by all its marshaling
+// methods: as SQL NULL, JSON and YAML null, the BSON null type, the text
+// "null" and empty binary data.  Conversely, decoding any of those yields a
+// NullUUID that is not valid.
 type NullUUID struct {
 	UUID  UUID
 	Valid bool // Valid is true if UUID is not NULL
@@ -68,6 +72,10 @@ func (nu NullUUID) MarshalBinary() ([]byte, error) {
 
 // UnmarshalBinary implements encoding.BinaryUnmarshaler.
 func (nu *NullUUID) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*nu = NullUUID{}
+		return nil
+	}
 	if len(data) != 16 {
 		return fmt.Errorf("invalid UUID (got %d bytes)", len(data))
 	}
@@ -87,6 +95,10 @@ func (nu NullUUID) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (nu *NullUUID) UnmarshalText(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*nu = NullUUID{}
+		return nil
+	}
 	id, err := ParseBytes(data)
 	if err != nil {
 		nu.Valid = false
@@ -116,3 +128,51 @@ func (nu *NullUUID) UnmarshalJSON(data []byte) error {
 	nu.Valid = err == nil
 	return err
 }
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v2 and
+// gopkg.in/yaml.v3.
+func (nu NullUUID) MarshalYAML() (interface{}, error) {
+	if nu.Valid {
+		return nu.UUID.String(), nil
+	}
+	return nil, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface of gopkg.in/yaml.v2,
+// which gopkg.in/yaml.v3 also supports.
+func (nu *NullUUID) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s *string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	if s == nil {
+		*nu = NullUUID{}
+		return nil
+	}
+	return nu.UnmarshalText([]byte(*s))
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of
+// go.mongodb.org/mongo-driver/v2.  A valid NullUUID is encoded as a UUID is.
+func (nu NullUUID) MarshalBSONValue() (byte, []byte, error) {
+	if nu.Valid {
+		return nu.UUID.MarshalBSONValue()
+	}
+	return bsonNull, nil, nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of
+// go.mongodb.org/mongo-driver/v2.
+func (nu *NullUUID) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == bsonNull {
+		*nu = NullUUID{}
+		return nil
+	}
+	var id UUID
+	if err := id.UnmarshalBSONValue(typ, data); err != nil {
+		nu.Valid = false
+		return err
+	}
+	nu.UUID, nu.Valid = id, true
+	return nil
+}
@@ -212,3 +212,68 @@ func TestNullUUIDUnmarshalJSON(t *testing.T) {
 		t.Errorf("expected nil when unmarshalling null, got %s", err)
 	}
 }
+
+func TestNullUUIDNullRoundTrip(t *testing.T) {
+	valid := NullUUID{UUID: MustParse("12345678-abcd-1234-abcd-0123456789ab"), Valid: true}
+	for _, nu := range []NullUUID{{}, valid} {
+		text, err := nu.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := NullUUID{UUID: Max, Valid: true}
+		if err := got.UnmarshalText(text); err != nil || got != nu {
+			t.Errorf("UnmarshalText(%q) got (%v, %v), want (%v, <nil>)", text, got, err, nu)
+		}
+
+		data, err := nu.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = NullUUID{UUID: Max, Valid: true}
+		if err := got.UnmarshalBinary(data); err != nil || got != nu {
+			t.Errorf("UnmarshalBinary(%x) got (%v, %v), want (%v, <nil>)", data, got, err, nu)
+		}
+
+		typ, data, err := nu.MarshalBSONValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = NullUUID{UUID: Max, Valid: true}
+		if err := got.UnmarshalBSONValue(typ, data); err != nil || got != nu {
+			t.Errorf("UnmarshalBSONValue(%#x, %x) got (%v, %v), want (%v, <nil>)", typ, data, got, err, nu)
+		}
+	}
+}
+
+// yamlUnmarshal mimics the unmarshal function passed to UnmarshalYAML by the
+// yaml packages for the scalar v, which is nil for a YAML null.
+func yamlUnmarshal(v interface{}) func(interface{}) error {
+	return func(out interface{}) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(data, out)
+	}
+}
+
+func TestNullUUIDYAML(t *testing.T) {
+	valid := NullUUID{UUID: MustParse("12345678-abcd-1234-abcd-0123456789ab"), Valid: true}
+	for _, nu := range []NullUUID{{}, valid} {
+		v, err := nu.MarshalYAML()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nu.Valid && v != nil {
+			t.Errorf("MarshalYAML() = %v, want nil", v)
+		}
+		got := NullUUID{UUID: Max, Valid: true}
+		if err := got.UnmarshalYAML(yamlUnmarshal(v)); err != nil || got != nu {
+			t.Errorf("UnmarshalYAML(%v) got (%v, %v), want (%v, <nil>)", v, got, err, nu)
+		}
+	}
+	var nu NullUUID
+	if err := nu.UnmarshalYAML(yamlUnmarshal("junk")); err == nil || nu.Valid {
+		t.Errorf("UnmarshalYAML(junk) got (%v, %v), want an error", nu, err)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"hash"
+)
+
+// obfuscateRounds is the number of rounds of the Feistel network of
+// ObfuscateTime.
+const obfuscateRounds = 8
+
+// ObfuscateTime returns uuid, a Version 7 UUID, with its 48 bit timestamp
+// encrypted with key, so that copies of the UUID handed out, such as in URLs,
+// do not disclose when it was generated, while the database keeps the
+// original, time ordered, UUID.  RevealTime with the same key returns the
+// original UUID.  All other bits are unchanged, so the result is also a
+// Version 7 UUID, with a meaningless time.  UUIDs of other versions are
+// returned unchanged.
+//
+// The timestamp is encrypted with format preserving encryption: a balanced
+// Feistel network of 8 rounds over its two 24 bit halves, with HMAC-SHA256
+// keyed with key as round function.  The remaining 80 bits of uuid tweak the
+// encryption, so that UUIDs of the same millisecond have unrelated encrypted
+// timestamps.  The key should be at least 32 random bytes.
+func ObfuscateTime(uuid UUID, key []byte) UUID {
+	if uuid.Version() != 7 {
+		return uuid
+	}
+	l, r := timeHalves(uuid)
+	h := hmac.New(sha256.New, key)
+	for round := 0; round < obfuscateRounds; round++ {
+		l, r = r, l^feistelRound(h, uuid, round, r)
+	}
+	putTimeHalves(&uuid, l, r)
+	return uuid
+}
+
+// RevealTime returns the original UUID of uuid, a UUID returned by
+// ObfuscateTime with key.  UUIDs of versions other than 7 are returned
+// unchanged.
+func RevealTime(uuid UUID, key []byte) UUID {
+	if uuid.Version() != 7 {
+		return uuid
+	}
+	l, r := timeHalves(uuid)
+	h := hmac.New(sha256.New, key)
+	for round := obfuscateRounds - 1; round >= 0; round-- {
+		l, r = r^feistelRound(h, uuid, round, l), l
+	}
+	putTimeHalves(&uuid, l, r)
+	return uuid
+}
+
+// timeHalves returns the two 24 bit halves of the timestamp of uuid.
+func timeHalves(uuid UUID) (l, r uint32) {
+	l = uint32(uuid[0])<<16 | uint32(uuid[1])<<8 | uint32(uuid[2])
+	r = uint32(uuid[3])<<16 | uint32(uuid[4])<<8 | uint32(uuid[5])
+	return l, r
+}
+
+// putTimeHalves stores the two 24 bit halves l and r of a timestamp in uuid.
+func putTimeHalves(uuid *UUID, l, r uint32) {
+	uuid[0], uuid[1], uuid[2] = byte(l>>16), byte(l>>8), byte(l)
+	uuid[3], uuid[4], uuid[5] = byte(r>>16), byte(r>>8), byte(r)
+}
+
+// feistelRound returns the 24 bit output of the round function of round for
+// the half x, tweaked by the bits of uuid after the timestamp.
+func feistelRound(h hash.Hash, uuid UUID, round int, x uint32) uint32 {
+	var msg [14]byte
+	msg[0] = byte(round)
+	msg[1], msg[2], msg[3] = byte(x>>16), byte(x>>8), byte(x)
+	copy(msg[4:], uuid[6:])
+	h.Reset()
+	h.Write(msg[:]) //nolint:errcheck
+	var sum [sha256.Size]byte
+	out := h.Sum(sum[:0])
+	return uint32(out[0])<<16 | uint32(out[1])<<8 | uint32(out[2])
+}
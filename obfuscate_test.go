@@ -0,0 +1,45 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestObfuscateTime(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	seen := map[[6]byte]bool{}
+	for i := 0; i < 1000; i++ {
+		u := Must(NewV7())
+		o := ObfuscateTime(u, key)
+		if o.Version() != 7 || o.Variant() != RFC4122 || !bytes.Equal(o[6:], u[6:]) {
+			t.Fatalf("ObfuscateTime(%s) = %s, want only the timestamp changed", u, o)
+		}
+		if bytes.Equal(o[:6], u[:6]) {
+			t.Errorf("ObfuscateTime(%s) did not change the timestamp", u)
+		}
+		var ts [6]byte
+		copy(ts[:], o[:6])
+		if seen[ts] {
+			t.Errorf("obfuscated timestamp %x repeated", ts)
+		}
+		seen[ts] = true
+		if r := RevealTime(o, key); r != u {
+			t.Fatalf("RevealTime(%s) = %s, want %s", o, r, u)
+		}
+		if r := RevealTime(o, []byte("another key")); r == u {
+			t.Errorf("RevealTime with another key returned %s", u)
+		}
+	}
+	for _, u := range []UUID{Nil, testUUID, Must(NewRandom())} {
+		if o := ObfuscateTime(u, key); o != u {
+			t.Errorf("ObfuscateTime changed %s of version %s", u, u.Version())
+		}
+		if r := RevealTime(u, key); r != u {
+			t.Errorf("RevealTime changed %s of version %s", u, u.Version())
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/base64"
+
+// swapGUID converts between the byte order of UUIDs and the mixed endian byte
+// order of Microsoft GUIDs, whose first three fields are little endian.
+// Applying swapGUID twice returns the original value.
+func swapGUID(b [16]byte) [16]byte {
+	b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
+	b[4], b[5] = b[5], b[4]
+	b[6], b[7] = b[7], b[6]
+	return b
+}
+
+// FromObjectGUID returns the UUID of the objectGUID attribute of an Active
+// Directory object, as returned by LDAP in base64 (for instance by ldapsearch
+// and in LDIF files).  The attribute holds the 16 bytes of the GUID in the
+// mixed endian byte order of Microsoft, so its bytes are not those of the
+// UUID.  Both padded and unpadded base64 are accepted.
+func FromObjectGUID(s string) (UUID, error) {
+	enc := base64.StdEncoding
+	if len(s)%4 != 0 {
+		enc = base64.RawStdEncoding
+	}
+	b, err := enc.DecodeString(s)
+	if err != nil {
+		return Nil, err
+	}
+	return FromObjectGUIDBytes(b)
+}
+
+// FromObjectGUIDBytes is like FromObjectGUID for the raw 16 bytes of the
+// attribute.
+func FromObjectGUIDBytes(b []byte) (UUID, error) {
+	var uuid UUID
+	if err := uuid.UnmarshalBinary(b); err != nil {
+		return Nil, err
+	}
+	return swapGUID(uuid), nil
+}
+
+// ObjectGUID returns uuid as the base64 encoded value of an Active Directory
+// objectGUID attribute, for instance for an LDAP search filter.
+func (uuid UUID) ObjectGUID() string {
+	b := uuid.ObjectGUIDBytes()
+	return base64.StdEncoding.EncodeToString(b[:])
+}
+
+// ObjectGUIDBytes returns uuid as the raw bytes of an Active Directory
+// objectGUID attribute.
+func (uuid UUID) ObjectGUIDBytes() [16]byte {
+	return swapGUID(uuid)
+}
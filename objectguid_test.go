@@ -0,0 +1,33 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestObjectGUID(t *testing.T) {
+	uuid := MustParse("5f6ed1e4-bc3e-4a7c-8d3b-1f2e3d4c5b6a")
+	const want = "5NFuXz68fEqNOx8uPUxbag=="
+	if got := uuid.ObjectGUID(); got != want {
+		t.Errorf("ObjectGUID() = %q, want %q", got, want)
+	}
+	for _, s := range []string{want, want[:22]} {
+		got, err := FromObjectGUID(s)
+		if err != nil || got != uuid {
+			t.Errorf("FromObjectGUID(%q) = %s, %v, want %s", s, got, err, uuid)
+		}
+	}
+	b := uuid.ObjectGUIDBytes()
+	if b[0] != 0xe4 || b[4] != 0x3e || b[6] != 0x7c || b[8] != 0x8d {
+		t.Errorf("ObjectGUIDBytes() = %x", b)
+	}
+	if got, err := FromObjectGUIDBytes(b[:]); err != nil || got != uuid {
+		t.Errorf("FromObjectGUIDBytes = %s, %v, want %s", got, err, uuid)
+	}
+	for _, s := range []string{"", "not base64!", "AAAA"} {
+		if _, err := FromObjectGUID(s); err == nil {
+			t.Errorf("FromObjectGUID(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+)
+
+// OracleRAW is a UUID stored in Oracle databases as RAW(16).  Oracle stores
+// the bytes of a RAW in order, so the RAW(16) of a UUID holds the bytes of the
+// UUID, and RAWTOHEX and HEXTORAW convert it from and to the form returned by
+// String, as 32 upper case hex digits.  Unlike a .NET Guid, no byte of the
+// first three fields is swapped.
+type OracleRAW UUID
+
+// Value implements sql.Valuer, binding o as the 16 bytes of a RAW(16).
+func (o OracleRAW) Value() (driver.Value, error) {
+	return o[:], nil
+}
+
+// Scan implements sql.Scanner.  The 16 bytes of a RAW(16), as well as the
+// output of RAWTOHEX and all the forms accepted by Parse, are accepted.
+func (o *OracleRAW) Scan(src interface{}) error {
+	return (*UUID)(o).Scan(src)
+}
+
+// String returns o as RAWTOHEX does, in the form returned by FormatSAP.
+func (o OracleRAW) String() string {
+	return UUID(o).FormatSAP()
+}
+
+// FromSysGUID returns the UUID of b, a value generated by the SYS_GUID
+// function of Oracle, either as the 16 bytes of its RAW(16) or as 32 hex
+// digits, as returned by RAWTOHEX.
+//
+// The values of SYS_GUID are not RFC 9562 UUIDs: their bytes derive from the
+// host, process and a counter, so that read as a UUID they have arbitrary
+// version and variant bits.  FromSysGUID returns instead the Version 8 UUID
+// of the SHA-256 digest of the 16 bytes, in the name-based layout of RFC 9562,
+// Appendix B.2, so that the same value always converts to the same UUID.  The
+// conversion cannot be reversed; use OracleRAW to keep the original bytes.
+func FromSysGUID(b []byte) (UUID, error) {
+	var raw [16]byte
+	switch len(b) {
+	case 16:
+		copy(raw[:], b)
+	case 32:
+		if _, err := hex.Decode(raw[:], b); err != nil {
+			return Nil, ErrInvalidUUIDFormat
+		}
+	default:
+		return Nil, invalidLengthError{len(b)}
+	}
+	sum := sha256.Sum256(raw[:])
+	return fromSum(sum[:]), nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestOracleRAW(t *testing.T) {
+	o := OracleRAW(NameSpaceDNS)
+	v, err := o.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := v.([]byte); !ok || !bytes.Equal(b, NameSpaceDNS[:]) {
+		t.Errorf("Value() = %v, want the bytes of %s", v, NameSpaceDNS)
+	}
+	if s, want := o.String(), "6BA7B8109DAD11D180B400C04FD430C8"; s != want {
+		t.Errorf("String() = %s, want %s", s, want)
+	}
+	for _, src := range []interface{}{NameSpaceDNS[:], "6BA7B8109DAD11D180B400C04FD430C8"} {
+		var got OracleRAW
+		if err := got.Scan(src); err != nil || got != o {
+			t.Errorf("Scan(%v) = %s, %v, want %s", src, got, err, o)
+		}
+	}
+}
+
+func TestFromSysGUID(t *testing.T) {
+	const s = "0A8F4C2E1B7D4E2FE0630A00A8C0B41D"
+	raw, _ := hex.DecodeString(s)
+	u1, err := FromSysGUID(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u1.Version() != 8 || u1.Variant() != RFC4122 {
+		t.Errorf("FromSysGUID returned %s of version %s and variant %s", u1, u1.Version(), u1.Variant())
+	}
+	u2, err := FromSysGUID([]byte(s))
+	if err != nil || u2 != u1 {
+		t.Errorf("FromSysGUID(%s) = %s, %v, want %s", s, u2, err, u1)
+	}
+	raw[15]++
+	if u3, _ := FromSysGUID(raw); u3 == u1 {
+		t.Errorf("distinct values converted to %s", u1)
+	}
+	for _, b := range []string{"", s[:30], s[:31] + "X"} {
+		if _, err := FromSysGUID([]byte(b)); err == nil {
+			t.Errorf("FromSysGUID(%q) succeeded", b)
+		}
+	}
+}
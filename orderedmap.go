@@ -0,0 +1,216 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import "iter"
+
+// An OrderedMap is a map keyed by UUIDs that iterates over its keys in
+// order, for in-memory indexes of records keyed by Version 7 UUIDs.  Get, Set
+// and Delete take O(log n) time.  The keys are ordered by Compare, which is
+// the time order of Version 6 and Version 7 UUIDs, unless another order is
+// chosen with NewOrderedMap.  The zero OrderedMap is empty and ready to use.
+//
+// An OrderedMap is not safe for concurrent use.
+type OrderedMap[V any] struct {
+	cmp  func(a, b UUID) int
+	root *mapNode[V]
+	len  int
+}
+
+// A mapNode is a node of the AVL tree of an OrderedMap.
+type mapNode[V any] struct {
+	key         UUID
+	value       V
+	left, right *mapNode[V]
+	height      int
+}
+
+// NewOrderedMap returns an empty OrderedMap ordering its keys by cmp, such as
+//
+//	func(a, b uuid.UUID) int { return uuid.CompareScrambled(a, b, 4) }
+//
+// If cmp is nil, Compare is used.
+func NewOrderedMap[V any](cmp func(a, b UUID) int) *OrderedMap[V] {
+	return &OrderedMap[V]{cmp: cmp}
+}
+
+func (m *OrderedMap[V]) compare(a, b UUID) int {
+	if m.cmp == nil {
+		return Compare(a, b)
+	}
+	return m.cmp(a, b)
+}
+
+// Len returns the number of keys in m.
+func (m *OrderedMap[V]) Len() int {
+	return m.len
+}
+
+// Get returns the value of key and true, or the zero value and false if key
+// is not in m.
+func (m *OrderedMap[V]) Get(key UUID) (V, bool) {
+	n := m.root
+	for n != nil {
+		switch c := m.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Set sets the value of key to value.
+func (m *OrderedMap[V]) Set(key UUID, value V) {
+	m.root = m.insert(m.root, key, value)
+}
+
+func (m *OrderedMap[V]) insert(n *mapNode[V], key UUID, value V) *mapNode[V] {
+	if n == nil {
+		m.len++
+		return &mapNode[V]{key: key, value: value, height: 1}
+	}
+	switch c := m.compare(key, n.key); {
+	case c < 0:
+		n.left = m.insert(n.left, key, value)
+	case c > 0:
+		n.right = m.insert(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+// Delete removes key from m and reports whether it was in m.
+func (m *OrderedMap[V]) Delete(key UUID) bool {
+	n := m.len
+	m.root = m.delete(m.root, key)
+	return m.len < n
+}
+
+func (m *OrderedMap[V]) delete(n *mapNode[V], key UUID) *mapNode[V] {
+	if n == nil {
+		return nil
+	}
+	switch c := m.compare(key, n.key); {
+	case c < 0:
+		n.left = m.delete(n.left, key)
+	case c > 0:
+		n.right = m.delete(n.right, key)
+	default:
+		m.len--
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var min *mapNode[V]
+		n.right, min = n.right.deleteMin()
+		min.left, min.right = n.left, n.right
+		n = min
+	}
+	return n.rebalance()
+}
+
+// deleteMin removes the node with the smallest key from the tree of n and
+// returns the new root and the removed node.
+func (n *mapNode[V]) deleteMin() (root, min *mapNode[V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	n.left, min = n.left.deleteMin()
+	return n.rebalance(), min
+}
+
+// All returns an iterator over the keys and values of m in order.
+func (m *OrderedMap[V]) All() iter.Seq2[UUID, V] {
+	return func(yield func(UUID, V) bool) {
+		m.root.walk(nil, nil, m.compare, yield)
+	}
+}
+
+// Range returns an iterator over the keys of m between from and to,
+// inclusive, and their values, in order, such as the records of a time span
+// with the bounds returned by V7Bounds.
+func (m *OrderedMap[V]) Range(from, to UUID) iter.Seq2[UUID, V] {
+	return func(yield func(UUID, V) bool) {
+		m.root.walk(&from, &to, m.compare, yield)
+	}
+}
+
+// walk calls yield for the keys of the tree of n between from and to, if not
+// nil, in order, until yield returns false.  It returns false if yield did.
+func (n *mapNode[V]) walk(from, to *UUID, cmp func(a, b UUID) int, yield func(UUID, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	afterFrom := from == nil || cmp(n.key, *from) >= 0
+	beforeTo := to == nil || cmp(n.key, *to) <= 0
+	if afterFrom && !n.left.walk(from, to, cmp, yield) {
+		return false
+	}
+	if afterFrom && beforeTo && !yield(n.key, n.value) {
+		return false
+	}
+	if beforeTo {
+		return n.right.walk(from, to, cmp, yield)
+	}
+	return true
+}
+
+func (n *mapNode[V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *mapNode[V]) updateHeight() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+}
+
+// rebalance restores the AVL property of n, whose subtrees are balanced and
+// differ in height by at most 2, and returns the new root of its tree.
+func (n *mapNode[V]) rebalance() *mapNode[V] {
+	n.updateHeight()
+	switch d := n.left.getHeight() - n.right.getHeight(); {
+	case d > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case d < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+func (n *mapNode[V]) rotateLeft() *mapNode[V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.updateHeight()
+	r.updateHeight()
+	return r
+}
+
+func (n *mapNode[V]) rotateRight() *mapNode[V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.updateHeight()
+	l.updateHeight()
+	return l
+}
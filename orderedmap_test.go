@@ -0,0 +1,102 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package uuid
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkAVL returns the height of the tree of n and fails t if it is not a
+// balanced search tree.
+func checkAVL[V any](t *testing.T, n *mapNode[V]) int {
+	if n == nil {
+		return 0
+	}
+	l, r := checkAVL(t, n.left), checkAVL(t, n.right)
+	if l-r > 1 || r-l > 1 || n.height != 1+max(l, r) {
+		t.Fatalf("node %s unbalanced: heights %d and %d, height %d", n.key, l, r, n.height)
+	}
+	if n.left != nil && Compare(n.left.key, n.key) >= 0 || n.right != nil && Compare(n.right.key, n.key) <= 0 {
+		t.Fatalf("node %s out of order", n.key)
+	}
+	return n.height
+}
+
+func TestOrderedMap(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var m OrderedMap[int]
+	want := map[UUID]int{}
+	for i := 0; i < 2000; i++ {
+		k := fromUint128(0, uint64(r.Intn(500)))
+		if r.Intn(3) == 0 {
+			_, ok := want[k]
+			if m.Delete(k) != ok {
+				t.Fatalf("Delete(%s) = %t, want %t", k, !ok, ok)
+			}
+			delete(want, k)
+		} else {
+			m.Set(k, i)
+			want[k] = i
+		}
+	}
+	checkAVL(t, m.root)
+	if m.Len() != len(want) {
+		t.Errorf("Len() = %d, want %d", m.Len(), len(want))
+	}
+	var keys []UUID
+	for k, v := range m.All() {
+		if v != want[k] {
+			t.Errorf("All: %s = %d, want %d", k, v, want[k])
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) != len(want) || !slices.IsSortedFunc(keys, Compare) {
+		t.Errorf("All returned %d keys, sorted %t", len(keys), slices.IsSortedFunc(keys, Compare))
+	}
+	for k, v := range want {
+		if got, ok := m.Get(k); got != v || !ok {
+			t.Errorf("Get(%s) = %d, %t, want %d", k, got, ok, v)
+		}
+	}
+	if _, ok := m.Get(Max); ok {
+		t.Errorf("Get(Max) found a value")
+	}
+
+	from, to := fromUint128(0, 100), fromUint128(0, 200)
+	var inRange []UUID
+	for k := range m.Range(from, to) {
+		inRange = append(inRange, k)
+	}
+	var wantRange []UUID
+	for _, k := range keys {
+		if Compare(k, from) >= 0 && Compare(k, to) <= 0 {
+			wantRange = append(wantRange, k)
+		}
+	}
+	if !slices.Equal(inRange, wantRange) {
+		t.Errorf("Range returned %d keys, want %d", len(inRange), len(wantRange))
+	}
+	for range m.All() {
+		break
+	}
+}
+
+func TestOrderedMapCompare(t *testing.T) {
+	m := NewOrderedMap[string](func(a, b UUID) int { return Compare(b, a) })
+	m.Set(Nil, "nil")
+	m.Set(Max, "max")
+	var got []string
+	for _, v := range m.All() {
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []string{"max", "nil"}) {
+		t.Errorf("All() = %v in reverse order", got)
+	}
+}
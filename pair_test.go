@@ -0,0 +1,74 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"unsafe"
+)
+
+func TestPair(t *testing.T) {
+	p := Pair{NameSpaceDNS, NameSpaceURL}
+	const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8:6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	if got := p.String(); got != s {
+		t.Errorf("String() = %s, want %s", got, s)
+	}
+	if got, err := ParsePair(s); err != nil || got != p {
+		t.Errorf("ParsePair(%s) = %v, %v, want %v", s, got, err, p)
+	}
+	if got, err := ParsePair("{" + NameSpaceDNS.String() + "}:" + NameSpaceURL.String()); err != nil || got != p {
+		t.Errorf("ParsePair of braced UUIDs = %v, %v, want %v", got, err, p)
+	}
+	for _, bad := range []string{"", s[:36], s + ":" + s[:36], s[:72], ":" + s[37:], "urn:uuid:" + s} {
+		if _, err := ParsePair(bad); err == nil {
+			t.Errorf("ParsePair(%q) succeeded", bad)
+		}
+	}
+	if size := unsafe.Sizeof(p); size != 32 {
+		t.Errorf("Pair is %d bytes, want 32", size)
+	}
+
+	b, err := json.Marshal(map[string]Pair{"edge": p})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]Pair
+	if err := json.Unmarshal(b, &m); err != nil || m["edge"] != p {
+		t.Errorf("JSON round trip of %s = %v, %v", b, m, err)
+	}
+
+	bin, _ := p.MarshalBinary()
+	var q Pair
+	if err := q.UnmarshalBinary(bin); err != nil || q != p {
+		t.Errorf("binary round trip = %v, %v, want %v", q, err, p)
+	}
+	if err := q.UnmarshalBinary(bin[:16]); err == nil {
+		t.Errorf("UnmarshalBinary of 16 bytes succeeded")
+	}
+}
+
+func TestComparePairs(t *testing.T) {
+	pairs := []Pair{{Nil, Max}, {NameSpaceDNS, Nil}, {NameSpaceDNS, NameSpaceURL}, {NameSpaceURL, Nil}}
+	for i := range pairs {
+		for j := range pairs {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := ComparePairs(pairs[i], pairs[j]); got != want {
+				t.Errorf("ComparePairs(%s, %s) = %d, want %d", pairs[i], pairs[j], got, want)
+			}
+			bi, _ := pairs[i].MarshalBinary()
+			bj, _ := pairs[j].MarshalBinary()
+			if got := bytes.Compare(bi, bj); got != want {
+				t.Errorf("binary encodings of %s and %s compare as %d, want %d", pairs[i], pairs[j], got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A VersionError is returned by the methods of a VersionPolicy for UUIDs of
+// a version the policy does not allow.
+type VersionError struct {
+	Version Version // version of the rejected UUID
+	allowed uint16  // bit v is set if version v is allowed
+}
+
+func (e VersionError) Error() string {
+	var want []string
+	for v := 0; v < 16; v++ {
+		if e.allowed&(1<<v) != 0 {
+			want = append(want, fmt.Sprint(v))
+		}
+	}
+	return fmt.Sprintf("UUID version %d not allowed, want version %s", e.Version, strings.Join(want, ", "))
+}
+
+func (e VersionError) Is(target error) bool {
+	_, ok := target.(VersionError)
+	return ok
+}
+
+// ErrVersionNotAllowed matches, using errors.Is, the VersionErrors returned by
+// the methods of a VersionPolicy.
+var ErrVersionNotAllowed = VersionError{}
+
+// A VersionPolicy accepts UUIDs of a set of versions and rejects all others,
+// for API layers that enforce a scheme of identifiers, such as accepting only
+// Version 7 UUIDs as order IDs, or both Version 4 and Version 7 UUIDs during
+// a migration.  A VersionPolicy is safe for concurrent use by multiple
+// goroutines.
+type VersionPolicy struct {
+	allowed uint16 // bit v is set if version v is allowed
+}
+
+// RequireVersion returns a VersionPolicy allowing the versions v.  Versions
+// greater than 15 are ignored.
+func RequireVersion(v ...Version) *VersionPolicy {
+	p := &VersionPolicy{}
+	for _, v := range v {
+		if v < 16 {
+			p.allowed |= 1 << v
+		}
+	}
+	return p
+}
+
+// Check returns nil if the version of uuid is allowed by p, and a
+// VersionError otherwise.
+func (p *VersionPolicy) Check(uuid UUID) error {
+	if v := uuid.Version(); p.allowed&(1<<v) == 0 {
+		return VersionError{Version: v, allowed: p.allowed}
+	}
+	return nil
+}
+
+// Parse is like Parse but also returns a VersionError if the version of the
+// UUID is not allowed by p.
+func (p *VersionPolicy) Parse(s string) (UUID, error) {
+	uuid, err := Parse(s)
+	if err != nil {
+		return Nil, err
+	}
+	if err := p.Check(uuid); err != nil {
+		return Nil, err
+	}
+	return uuid, nil
+}
+
+// ParseBytes is like ParseBytes but also returns a VersionError if the version
+// of the UUID is not allowed by p.
+func (p *VersionPolicy) ParseBytes(b []byte) (UUID, error) {
+	uuid, err := ParseBytes(b)
+	if err != nil {
+		return Nil, err
+	}
+	if err := p.Check(uuid); err != nil {
+		return Nil, err
+	}
+	return uuid, nil
+}
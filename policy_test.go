@@ -0,0 +1,48 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequireVersion(t *testing.T) {
+	p := RequireVersion(4, 7)
+	v4, v7 := New(), Must(NewV7())
+	v5 := NewSHA1(NameSpaceDNS, []byte("example.com"))
+	for _, u := range []UUID{v4, v7} {
+		if err := p.Check(u); err != nil {
+			t.Errorf("Check(%s): %v", u, err)
+		}
+		if got, err := p.Parse(u.String()); got != u || err != nil {
+			t.Errorf("Parse(%s) = %s, %v", u, got, err)
+		}
+		if got, err := p.ParseBytes([]byte(u.URN())); got != u || err != nil {
+			t.Errorf("ParseBytes(%s) = %s, %v", u.URN(), got, err)
+		}
+	}
+
+	err := p.Check(v5)
+	if !errors.Is(err, ErrVersionNotAllowed) {
+		t.Fatalf("Check(%s) got error %v, want %v", v5, err, ErrVersionNotAllowed)
+	}
+	var verr VersionError
+	if !errors.As(err, &verr) || verr.Version != 5 {
+		t.Errorf("Check(%s) returned %#v", v5, err)
+	}
+	if want := "UUID version 5 not allowed, want version 4, 7"; err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+	if got, err := p.Parse(v5.String()); got != Nil || !errors.Is(err, ErrVersionNotAllowed) {
+		t.Errorf("Parse(%s) = %s, %v", v5, got, err)
+	}
+	if _, err := p.Parse("not-a-uuid"); err == nil || errors.Is(err, ErrVersionNotAllowed) {
+		t.Errorf("Parse of an invalid UUID got error %v", err)
+	}
+	if err := RequireVersion().Check(v4); !errors.Is(err, ErrVersionNotAllowed) {
+		t.Errorf("empty policy accepted %s", v4)
+	}
+}
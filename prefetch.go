@@ -0,0 +1,87 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrPrefetcherClosed is returned by Prefetcher.Next after Close once the
+// prefetched UUIDs are used up.
+var ErrPrefetcherClosed = errors.New("UUID prefetcher closed")
+
+// A Prefetcher calls a function generating UUIDs, such as NewV7 or the
+// NewRandom method of a Generator, in a background goroutine and keeps up to
+// depth of its UUIDs ready, so latency critical code paths receive a UUID
+// without reading randomness or waiting for locks.
+//
+// Time based UUIDs, such as those of NewV7, hold the time they were
+// prefetched rather than the time Next returned them.
+//
+// A Prefetcher is safe for concurrent use by multiple goroutines.
+type Prefetcher struct {
+	c       chan prefetched
+	done    chan struct{} // closed by Close
+	stopped chan struct{} // closed when run returns
+	once    sync.Once
+}
+
+type prefetched struct {
+	uuid UUID
+	err  error
+}
+
+// NewPrefetcher returns a Prefetcher keeping depth UUIDs of gen ready.  A
+// depth of less than 1 is taken as 1.  Close must be called to stop the
+// background goroutine.
+func NewPrefetcher(gen func() (UUID, error), depth int) *Prefetcher {
+	if depth < 1 {
+		depth = 1
+	}
+	p := &Prefetcher{
+		c:       make(chan prefetched, depth),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go p.run(gen)
+	return p
+}
+
+func (p *Prefetcher) run(gen func() (UUID, error)) {
+	defer close(p.stopped)
+	defer close(p.c)
+	for {
+		select {
+		case <-p.done:
+			return
+		default:
+		}
+		uuid, err := gen()
+		select {
+		case p.c <- prefetched{uuid, err}:
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// Next returns the next prefetched UUID, waiting for one if none is ready.
+// Errors of the generating function are returned by Next in turn.
+func (p *Prefetcher) Next() (UUID, error) {
+	v, ok := <-p.c
+	if !ok {
+		return Nil, ErrPrefetcherClosed
+	}
+	return v.uuid, v.err
+}
+
+// Close stops the background goroutine of p, waiting for a call of the
+// generating function in progress to return.  UUIDs prefetched before Close
+// are still returned by Next.
+func (p *Prefetcher) Close() {
+	p.once.Do(func() { close(p.done) })
+	<-p.stopped
+}
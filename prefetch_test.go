@@ -0,0 +1,67 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestPrefetcher(t *testing.T) {
+	p := NewPrefetcher(NewV7, 16)
+	defer p.Close()
+
+	var mu sync.Mutex
+	seen := map[UUID]bool{}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				uuid, err := p.Next()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				mu.Lock()
+				if seen[uuid] {
+					t.Errorf("duplicate UUID %s", uuid)
+				}
+				seen[uuid] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPrefetcherClose(t *testing.T) {
+	p := NewPrefetcher(NewRandom, 4)
+	p.Close()
+	p.Close()
+	for i := 0; ; i++ {
+		_, err := p.Next()
+		if err == ErrPrefetcherClosed {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i > 64 {
+			t.Fatalf("Next keeps returning UUIDs after Close")
+		}
+	}
+}
+
+func TestPrefetcherError(t *testing.T) {
+	want := errors.New("no entropy")
+	p := NewPrefetcher(func() (UUID, error) { return Nil, want }, 1)
+	defer p.Close()
+	if _, err := p.Next(); err != want {
+		t.Errorf("Next got error %v, want %v", err, want)
+	}
+}
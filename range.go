@@ -0,0 +1,179 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// A Range is the range of UUIDs between Min and Max, inclusive, in byte
+// order.  A Range with Min greater than Max is empty.
+type Range struct {
+	Min, Max UUID
+}
+
+// Contains reports whether uuid is within r.
+func (r Range) Contains(uuid UUID) bool {
+	return Compare(r.Min, uuid) <= 0 && Compare(uuid, r.Max) <= 0
+}
+
+// empty reports whether r contains no UUIDs.
+func (r Range) empty() bool {
+	return Compare(r.Min, r.Max) > 0
+}
+
+// A RangeSet is a set of UUIDs stored as disjoint ranges, for tracking which
+// parts of a keyspace have been processed, such as the ranges of keys already
+// migrated or backfilled by a large re-keying job.  Overlapping and adjacent
+// ranges are coalesced as they are inserted, so the size of a RangeSet grows
+// with the number of gaps between the ranges rather than with the number of
+// insertions.  The zero RangeSet is empty and ready to use.
+//
+// A RangeSet is not safe for concurrent use.
+type RangeSet struct {
+	ranges []Range // sorted, disjoint and not adjacent
+}
+
+// Insert adds the UUIDs of r to s.  Empty ranges are ignored.
+func (s *RangeSet) Insert(r Range) {
+	if r.empty() {
+		return
+	}
+	// The ranges from i to j-1 overlap or are adjacent to r.
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return Compare(s.ranges[i].Max, r.Min) >= 0 || adjacent(s.ranges[i].Max, r.Min)
+	})
+	j := sort.Search(len(s.ranges), func(j int) bool {
+		return Compare(s.ranges[j].Min, r.Max) > 0 && !adjacent(r.Max, s.ranges[j].Min)
+	})
+	if i < j {
+		if Compare(s.ranges[i].Min, r.Min) < 0 {
+			r.Min = s.ranges[i].Min
+		}
+		if Compare(s.ranges[j-1].Max, r.Max) > 0 {
+			r.Max = s.ranges[j-1].Max
+		}
+	}
+	switch {
+	case i == j:
+		s.ranges = append(s.ranges, Range{})
+		copy(s.ranges[i+1:], s.ranges[i:])
+	case j > i+1:
+		s.ranges = append(s.ranges[:i+1], s.ranges[j:]...)
+	}
+	s.ranges[i] = r
+}
+
+// Contains reports whether uuid is in s.
+func (s *RangeSet) Contains(uuid UUID) bool {
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return Compare(s.ranges[i].Max, uuid) >= 0
+	})
+	return i < len(s.ranges) && Compare(s.ranges[i].Min, uuid) <= 0
+}
+
+// Ranges returns the disjoint ranges of s in ascending order.
+func (s *RangeSet) Ranges() []Range {
+	return append([]Range(nil), s.ranges...)
+}
+
+// Len returns the number of disjoint ranges of s.
+func (s *RangeSet) Len() int {
+	return len(s.ranges)
+}
+
+// adjacent reports whether b immediately follows a.
+func adjacent(a, b UUID) bool {
+	hi, lo := uint128(a)
+	lo++
+	if lo == 0 {
+		if hi++; hi == 0 {
+			return false // a is Max
+		}
+	}
+	return fromUint128(hi, lo) == b
+}
+
+// SplitRange divides the range of UUIDs between min and max, inclusive, into
+// parts contiguous ranges of equal size, in ascending order, for
+// parallelizing scans of tables keyed by UUIDs and building shard maps.  The
+// sizes of the ranges differ by at most one UUID when the size of the range
+// is not a multiple of parts.  The whole keyspace is split with
+//
+//	uuid.SplitRange(uuid.Nil, uuid.Max, 16)
+//
+// Fewer than parts ranges are returned if the range holds fewer than parts
+// UUIDs, and none if min is greater than max or parts is less than 1.
+//
+// The ranges are of equal size in the keyspace, not in number of keys: the
+// leading bits of Version 6 and 7 UUIDs are their time, so their keys
+// gather in the ranges of the times they were generated at.
+func SplitRange(min, max UUID, parts int) []Range {
+	if parts < 1 || Compare(min, max) > 0 {
+		return nil
+	}
+	// The range holds span+1 UUIDs, q*parts+r, which may be 2^128.
+	p := uint64(parts)
+	mhi, mlo := uint128(min)
+	xhi, xlo := uint128(max)
+	shi, slo, _ := sub128(xhi, xlo, mhi, mlo)
+	qhi, qlo, r := div128(shi, slo, p)
+	var qcarry uint64 // q is 2^128 for the whole keyspace in one part
+	if r++; r == p {
+		r = 0
+		qhi, qlo, qcarry = add128(qhi, qlo, 0, 1)
+	}
+	if qhi == 0 && qlo == 0 && qcarry == 0 {
+		p = r // fewer UUIDs than parts, one per range
+	}
+	ranges := make([]Range, 0, p)
+	start := min
+	for i := uint64(1); i <= p; i++ {
+		// The next range starts at min + i*q + i*r/p.
+		ohi, olo := mul128(qhi, qlo, i)
+		rhi, rlo := bits.Mul64(i, r)
+		d, _ := bits.Div64(rhi, rlo, p)
+		ohi, olo, _ = add128(ohi, olo, 0, d)
+		ehi, elo, _ := add128(mhi, mlo, ohi, olo)
+		ehi, elo, _ = sub128(ehi, elo, 0, 1)
+		end := fromUint128(ehi, elo)
+		if i == p {
+			end = max
+		}
+		ranges = append(ranges, Range{Min: start, Max: end})
+		ehi, elo, _ = add128(ehi, elo, 0, 1)
+		start = fromUint128(ehi, elo)
+	}
+	return ranges
+}
+
+// add128 returns a + b and the carry, where a and b are 128 bit integers.
+func add128(ahi, alo, bhi, blo uint64) (hi, lo, carry uint64) {
+	lo, c := bits.Add64(alo, blo, 0)
+	hi, carry = bits.Add64(ahi, bhi, c)
+	return hi, lo, carry
+}
+
+// sub128 returns a - b and the borrow, where a and b are 128 bit integers.
+func sub128(ahi, alo, bhi, blo uint64) (hi, lo, borrow uint64) {
+	lo, b := bits.Sub64(alo, blo, 0)
+	hi, borrow = bits.Sub64(ahi, bhi, b)
+	return hi, lo, borrow
+}
+
+// mul128 returns the low 128 bits of a * m, where a is a 128 bit integer.
+func mul128(ahi, alo, m uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(alo, m)
+	return hi + ahi*m, lo
+}
+
+// div128 returns the quotient and remainder of a / d, where a is a 128 bit
+// integer.
+func div128(ahi, alo, d uint64) (qhi, qlo, r uint64) {
+	qhi, r = ahi/d, ahi%d
+	qlo, r = bits.Div64(r, alo, d)
+	return qhi, qlo, r
+}
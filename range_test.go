@@ -0,0 +1,148 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// rangeOf returns the Range between the UUIDs of the integers min and max.
+func rangeOf(min, max uint64) Range {
+	return Range{fromUint128(0, min), fromUint128(0, max)}
+}
+
+func TestRangeSet(t *testing.T) {
+	var s RangeSet
+	for _, r := range []Range{
+		rangeOf(10, 19),
+		rangeOf(40, 49),
+		rangeOf(30, 30),
+		rangeOf(60, 50), // empty
+		rangeOf(20, 25), // adjacent to 10-19
+		rangeOf(0, 5),
+		rangeOf(29, 35), // overlaps 30-30
+	} {
+		s.Insert(r)
+	}
+	want := []Range{rangeOf(0, 5), rangeOf(10, 25), rangeOf(29, 35), rangeOf(40, 49)}
+	if got := s.Ranges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Ranges() = %v, want %v", got, want)
+	}
+	s.Insert(rangeOf(3, 45))
+	if got := s.Ranges(); !reflect.DeepEqual(got, []Range{rangeOf(0, 49)}) {
+		t.Errorf("Ranges() after coalescing = %v", got)
+	}
+
+	s = RangeSet{}
+	s.Insert(Range{fromUint128(0, ^uint64(0)), Max})
+	s.Insert(Range{Nil, fromUint128(0, ^uint64(0)-1)})
+	if s.Len() != 1 || !s.Contains(Nil) || !s.Contains(Max) {
+		t.Errorf("Ranges() = %v, want the whole keyspace", s.Ranges())
+	}
+}
+
+func TestRangeSetContains(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var s RangeSet
+	var in [200]bool
+	for i := 0; i < 30; i++ {
+		min := uint64(r.Intn(200))
+		max := min + uint64(r.Intn(10))
+		if max >= 200 {
+			max = 199
+		}
+		s.Insert(rangeOf(min, max))
+		for j := min; j <= max; j++ {
+			in[j] = true
+		}
+	}
+	for i, want := range in {
+		if got := s.Contains(fromUint128(0, uint64(i))); got != want {
+			t.Errorf("Contains(%d) = %t, want %t", i, got, want)
+		}
+	}
+	rs := s.Ranges()
+	for i := 1; i < len(rs); i++ {
+		if Compare(rs[i-1].Max, rs[i].Min) >= 0 || adjacent(rs[i-1].Max, rs[i].Min) {
+			t.Errorf("ranges %v and %v are not coalesced", rs[i-1], rs[i])
+		}
+	}
+	if (Range{testUUID, testUUID}).Contains(NameSpaceDNS) || !(Range{Nil, Max}).Contains(testUUID) {
+		t.Errorf("Range.Contains is wrong")
+	}
+}
+
+func TestSplitRange(t *testing.T) {
+	for _, tt := range []struct {
+		r     Range
+		parts int
+		want  []Range
+	}{
+		{rangeOf(0, 9), 3, []Range{rangeOf(0, 2), rangeOf(3, 5), rangeOf(6, 9)}},
+		{rangeOf(10, 13), 2, []Range{rangeOf(10, 11), rangeOf(12, 13)}},
+		{rangeOf(5, 5), 1, []Range{rangeOf(5, 5)}},
+		{rangeOf(5, 7), 5, []Range{rangeOf(5, 5), rangeOf(6, 6), rangeOf(7, 7)}},
+		{rangeOf(5, 4), 2, nil},
+		{rangeOf(0, 9), 0, nil},
+		{Range{Nil, Max}, 1, []Range{{Nil, Max}}},
+	} {
+		if got := SplitRange(tt.r.Min, tt.r.Max, tt.parts); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitRange(%v, %d) = %v, want %v", tt.r, tt.parts, got, tt.want)
+		}
+	}
+
+	// The whole keyspace in 16 parts is split by the first hex digit.
+	ranges := SplitRange(Nil, Max, 16)
+	if len(ranges) != 16 {
+		t.Fatalf("SplitRange(Nil, Max, 16) returned %d ranges", len(ranges))
+	}
+	for i, r := range ranges {
+		min, max := FromPrefix(uint64(i), 4)
+		if r.Min != min || r.Max != max {
+			t.Errorf("range #%d = %s-%s, want %s-%s", i, r.Min, r.Max, min, max)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		var a, b UUID
+		rnd.Read(a[:])
+		rnd.Read(b[:])
+		if Compare(a, b) > 0 {
+			a, b = b, a
+		}
+		parts := 1 + rnd.Intn(100)
+		ranges := SplitRange(a, b, parts)
+		if len(ranges) != parts || ranges[0].Min != a || ranges[parts-1].Max != b {
+			t.Fatalf("SplitRange(%s, %s, %d) = %v", a, b, parts, ranges)
+		}
+		var minSize, maxSize UUID
+		for j, r := range ranges {
+			if j > 0 && !adjacent(ranges[j-1].Max, r.Min) {
+				t.Fatalf("SplitRange(%s, %s, %d): range #%d not contiguous", a, b, parts, j)
+			}
+			hi, lo := uint128(r.Max)
+			mhi, mlo := uint128(r.Min)
+			size := fromUint128(sub128Size(hi, lo, mhi, mlo))
+			if j == 0 || Compare(size, minSize) < 0 {
+				minSize = size
+			}
+			if j == 0 || Compare(size, maxSize) > 0 {
+				maxSize = size
+			}
+		}
+		if !adjacent(minSize, maxSize) && minSize != maxSize {
+			t.Fatalf("SplitRange(%s, %s, %d): sizes from %s to %s", a, b, parts, minSize, maxSize)
+		}
+	}
+}
+
+// sub128Size returns a - b, where a and b are 128 bit integers.
+func sub128Size(ahi, alo, bhi, blo uint64) (hi, lo uint64) {
+	hi, lo, _ = sub128(ahi, alo, bhi, blo)
+	return hi, lo
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by RateLimiter.Next when the rate limit is
+// exceeded.
+var ErrRateLimited = errors.New("UUID rate limit exceeded")
+
+// A RateLimiter wraps a function generating UUIDs, such as NewV7 or the
+// NewRandom method of a Generator, and limits the rate at which UUIDs are
+// returned, for test harnesses or to throttle the minting of identifiers per
+// tenant.  The limit is a token bucket: the RateLimiter starts with burst
+// tokens, regains perSecond tokens per second up to burst tokens, and each
+// UUID takes one token.
+//
+// A RateLimiter is safe for concurrent use by multiple goroutines.
+type RateLimiter struct {
+	gen       func() (UUID, error)
+	perSecond float64
+	burst     float64
+
+	mu     sync.Mutex
+	tokens float64   // protected by mu, negative when reserved by NextContext
+	last   time.Time // protected by mu, time tokens was last updated
+}
+
+// NewRateLimiter returns a RateLimiter allowing gen to be called perSecond
+// times per second on average and burst times in a row.  A burst of less than
+// 1 is taken as 1.  If perSecond is 0 or less no more UUIDs are returned
+// once the burst is used up.
+func NewRateLimiter(gen func() (UUID, error), perSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		gen:       gen,
+		perSecond: perSecond,
+		burst:     float64(burst),
+		tokens:    float64(burst),
+		last:      timeNow(),
+	}
+}
+
+// refill adds the tokens regained since r.last.  r.mu must be held.
+func (r *RateLimiter) refill() {
+	now := timeNow()
+	if elapsed := now.Sub(r.last); elapsed > 0 {
+		r.tokens += elapsed.Seconds() * r.perSecond
+		if r.tokens > r.burst {
+			r.tokens = r.burst
+		}
+	}
+	r.last = now
+}
+
+// Next returns the next UUID of the wrapped function, or Nil and
+// ErrRateLimited without waiting if the rate limit is exceeded.
+func (r *RateLimiter) Next() (UUID, error) {
+	r.mu.Lock()
+	r.refill()
+	if r.tokens < 1 {
+		r.mu.Unlock()
+		return Nil, ErrRateLimited
+	}
+	r.tokens--
+	r.mu.Unlock()
+	return r.gen()
+}
+
+// NextContext is like Next but waits until the rate limit allows another UUID
+// or ctx is done, in which case ctx.Err() is returned.
+func (r *RateLimiter) NextContext(ctx context.Context) (UUID, error) {
+	r.mu.Lock()
+	r.refill()
+	r.tokens--
+	var wait time.Duration
+	switch {
+	case r.tokens >= 0:
+	case r.perSecond <= 0:
+		wait = math.MaxInt64 // until ctx is done
+	default:
+		wait = time.Duration(-r.tokens / r.perSecond * float64(time.Second))
+	}
+	r.mu.Unlock()
+	if wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			r.mu.Lock()
+			r.tokens++
+			r.mu.Unlock()
+			return Nil, ctx.Err()
+		}
+	}
+	return r.gen()
+}
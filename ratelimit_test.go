@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	r := NewRateLimiter(NewRandom, 10, 3)
+	for i := 0; i < 3; i++ {
+		if _, err := r.Next(); err != nil {
+			t.Fatalf("Next #%d within burst: %v", i, err)
+		}
+	}
+	if _, err := r.Next(); err != ErrRateLimited {
+		t.Errorf("Next after burst got error %v, want %v", err, ErrRateLimited)
+	}
+	now = now.Add(50 * time.Millisecond)
+	if _, err := r.Next(); err != ErrRateLimited {
+		t.Errorf("Next after half a token got error %v, want %v", err, ErrRateLimited)
+	}
+	now = now.Add(50 * time.Millisecond)
+	if _, err := r.Next(); err != nil {
+		t.Errorf("Next after a token was regained: %v", err)
+	}
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if _, err := r.Next(); err != nil {
+			t.Fatalf("Next #%d after refill: %v", i, err)
+		}
+	}
+	if _, err := r.Next(); err != ErrRateLimited {
+		t.Errorf("refill exceeded burst")
+	}
+}
+
+func TestRateLimiterNextContext(t *testing.T) {
+	r := NewRateLimiter(NewRandom, 100, 1)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := r.NextContext(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 15*time.Millisecond {
+		t.Errorf("3 UUIDs at 100/s with burst 1 took %v, want at least 20ms", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = NewRateLimiter(NewRandom, 0, 1)
+	r.Next()
+	if _, err := r.NextContext(ctx); err != context.Canceled {
+		t.Errorf("NextContext got error %v, want %v", err, context.Canceled)
+	}
+	if r.tokens < -0.5 {
+		t.Errorf("canceled NextContext kept its reservation")
+	}
+}
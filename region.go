@@ -0,0 +1,47 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// regionTag marks the Version 8 UUIDs of NewV8Region.
+const regionTag = 0x4e9
+
+// NewV8Region returns a Version 8 UUID holding region, a code of the region
+// or datacenter in which the identified data resides, so that requests can be
+// routed to the region holding the data from the ID alone, without a lookup.
+// The meaning of the codes is left to the application.  The remaining bits
+// are the current time and random bits, read as NewRandom does.  The layout
+// is
+//
+//	unix_ts_ms  48 bits, Unix time in milliseconds, as in Version 7 UUIDs
+//	ver          4 bits, 8
+//	tag         12 bits, 0x4e9
+//	var          2 bits, 0b10
+//	region       8 bits
+//	rand        54 bits
+//
+// The UUIDs sort by time, to the millisecond.  The region is not
+// authenticated: a client may send an ID with another region, so the region
+// must only direct requests, not grant access to data.
+func NewV8Region(region uint8) (UUID, error) {
+	uuid, err := NewRandom()
+	if err != nil {
+		return Nil, err
+	}
+	putV7Time(uuid[:], TimeToUnixMilli(now()), regionTag)
+	uuid[6] = uuid[6]&0x0f | 0x80 // Version 8
+	uuid, _ = SetBits(uuid, 66, 8, uint64(region))
+	return uuid, nil
+}
+
+// Region returns the region of uuid, a UUID returned by NewV8Region, and
+// true, or 0 and false if uuid is not a Version 8 UUID tagged by NewV8Region.
+// The tag is only 12 bits long, so Region also returns true, with a
+// meaningless region, for one in 4096 Version 8 UUIDs of other origins.
+func Region(uuid UUID) (uint8, bool) {
+	if uuid.Version() != 8 || uuid.Variant() != RFC4122 || GetBits(uuid, 52, 12) != regionTag {
+		return 0, false
+	}
+	return uint8(GetBits(uuid, 66, 8)), true
+}
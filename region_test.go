@@ -0,0 +1,45 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewV8Region(t *testing.T) {
+	at := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return at }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	for _, region := range []uint8{0, 1, 42, 255} {
+		u, err := NewV8Region(region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Version() != 8 || u.Variant() != RFC4122 {
+			t.Errorf("NewV8Region returned %s of version %s and variant %s", u, u.Version(), u.Variant())
+		}
+		if got, ok := Region(u); !ok || got != region {
+			t.Errorf("Region(%s) = %d, %v, want %d", u, got, ok, region)
+		}
+		if ms := int64(GetBits(u, 0, 48)); ms != TimeToUnixMilli(at) {
+			t.Errorf("NewV8Region(%d) has time %d, want %d", region, ms, TimeToUnixMilli(at))
+		}
+	}
+	u1 := Must(NewV8Region(255))
+	at = at.Add(time.Millisecond)
+	if u2 := Must(NewV8Region(0)); Compare(u1, u2) >= 0 {
+		t.Errorf("UUIDs do not sort by time")
+	}
+
+	for _, u := range []UUID{Nil, Must(NewRandom()), Must(NewV7()), Must(NewV8WithExpiry(time.Hour))} {
+		if r, ok := Region(u); ok {
+			t.Errorf("Region(%s) = %d, true, want false", u, r)
+		}
+	}
+}
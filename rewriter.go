@@ -0,0 +1,93 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// V1ToV6 returns the Version 6 UUID of uuid, a Version 1 UUID: the same time,
+// clock sequence and node, with the time fields reordered so that the UUIDs
+// sort by time, as described in RFC 9562, Section 5.6.  UUIDs of other
+// versions are returned unchanged.  V6ToV1 reverses the conversion.
+func V1ToV6(uuid UUID) UUID {
+	if uuid.Version() != 1 {
+		return uuid
+	}
+	t := uint64(uuid.Time())
+	binary.BigEndian.PutUint32(uuid[0:], uint32(t>>28))
+	binary.BigEndian.PutUint16(uuid[4:], uint16(t>>12))
+	binary.BigEndian.PutUint16(uuid[6:], uint16(t&0xfff)|0x6000)
+	return uuid
+}
+
+// V6ToV1 returns the Version 1 UUID of uuid, a Version 6 UUID, reversing
+// V1ToV6.  UUIDs of other versions are returned unchanged.
+func V6ToV1(uuid UUID) UUID {
+	if uuid.Version() != 6 {
+		return uuid
+	}
+	t := uint64(uuid.Time())
+	binary.BigEndian.PutUint32(uuid[0:], uint32(t))
+	binary.BigEndian.PutUint16(uuid[4:], uint16(t>>32))
+	binary.BigEndian.PutUint16(uuid[6:], uint16(t>>48&0xfff)|0x1000)
+	return uuid
+}
+
+// A Rewriter rewrites the UUIDs of a dataset, such as its primary and foreign
+// keys, to newer versions, for large scale key migrations: Version 1 UUIDs
+// to the Version 6 UUIDs of V1ToV6, and, if a key is given, Version 4 UUIDs
+// to the Version 8 UUIDs of NewKeyed with the key and the 16 bytes of the
+// UUID.  Both conversions are deterministic, so the same UUID is rewritten
+// the same way wherever it appears, also in separate runs.  UUIDs of other
+// versions are not rewritten.
+//
+// Each rewritten UUID is recorded in the mapping table of the Rewriter, if
+// any, as a line of the old and the new UUID separated by a comma, so that
+// the migration can be reversed, and references held by other systems
+// translated.  A UUID appearing several times in the dataset is recorded as
+// many times.
+//
+// A Rewriter is not safe for concurrent use.
+type Rewriter struct {
+	key     []byte
+	mapping io.Writer
+	buf     [36*2 + 2]byte
+}
+
+// NewRewriter returns a Rewriter rewriting Version 4 UUIDs with key, if not
+// nil, and recording the mapping table in mapping, if not nil.
+func NewRewriter(key []byte, mapping io.Writer) *Rewriter {
+	r := &Rewriter{mapping: mapping}
+	if key != nil {
+		r.key = append([]byte(nil), key...)
+	}
+	return r
+}
+
+// Rewrite returns the rewritten UUID of uuid, or uuid if it is not
+// rewritten.  An error is returned if the mapping table cannot be written.
+func (r *Rewriter) Rewrite(uuid UUID) (UUID, error) {
+	var to UUID
+	switch {
+	case uuid.Version() == 1 && uuid.Variant() == RFC4122:
+		to = V1ToV6(uuid)
+	case uuid.Version() == 4 && uuid.Variant() == RFC4122 && r.key != nil:
+		to = NewKeyed(r.key, uuid[:])
+	default:
+		return uuid, nil
+	}
+	if r.mapping != nil {
+		encodeHex(r.buf[:36], uuid)
+		r.buf[36] = ','
+		encodeHex(r.buf[37:73], to)
+		r.buf[73] = '\n'
+		if _, err := r.mapping.Write(r.buf[:]); err != nil {
+			return Nil, err
+		}
+	}
+	return to, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestV1ToV6(t *testing.T) {
+	at := time.Date(2024, 10, 15, 9, 32, 23, 123456700, time.UTC)
+	v1, err := NewUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v6 := V1ToV6(v1)
+	if v6.Version() != 6 || v6.Time() != v1.Time() || v6.ClockSequence() != v1.ClockSequence() || v6.NodeID()[0] != v1.NodeID()[0] {
+		t.Errorf("V1ToV6(%s) = %s", v1, v6)
+	}
+	if back := V6ToV1(v6); back != v1 {
+		t.Errorf("V6ToV1(%s) = %s, want %s", v6, back, v1)
+	}
+	// The UUID of NewV6WithTime is that of NewUUID with the same fields.
+	w6, _ := NewV6WithTime(&at)
+	if w1 := V6ToV1(w6); w1.Version() != 1 || V1ToV6(w1) != w6 || w1.Time() != w6.Time() {
+		t.Errorf("V6ToV1(%s) = %s", w6, w1)
+	}
+	for _, u := range []UUID{Nil, testUUID, Must(NewRandom()), Must(NewV7())} {
+		if V1ToV6(u) != u || V6ToV1(u) != u {
+			t.Errorf("UUID %s of version %s converted", u, u.Version())
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestRewriter(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	var table strings.Builder
+	r := NewRewriter(key, &table)
+
+	v1 := NameSpaceDNS
+	v4 := Must(NewRandom())
+	v7 := Must(NewV7())
+	for _, tt := range []struct {
+		in, want UUID
+	}{
+		{v1, V1ToV6(v1)},
+		{v4, NewKeyed(key, v4[:])},
+		{v7, v7},
+		{Nil, Nil},
+		{v4, NewKeyed(key, v4[:])},
+	} {
+		got, err := r.Rewrite(tt.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Rewrite(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+	lines := strings.Split(strings.TrimSuffix(table.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got mapping table %q, want 3 lines", table.String())
+	}
+	if want := v1.String() + "," + V1ToV6(v1).String(); lines[0] != want {
+		t.Errorf("got mapping %q, want %q", lines[0], want)
+	}
+
+	if u, _ := NewRewriter(nil, nil).Rewrite(v4); u != v4 {
+		t.Errorf("Rewriter without key rewrote %s to %s", v4, u)
+	}
+	if _, err := NewRewriter(key, failingWriter{}).Rewrite(v4); err == nil {
+		t.Errorf("Rewrite did not return the error of the mapping table")
+	}
+}
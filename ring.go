@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// ringPointsPerWeight is the number of points a member of a Ring has on the
+// ring per unit of weight.  More points spread the keys more evenly.
+const ringPointsPerWeight = 128
+
+// A Ring assigns UUIDs to members by consistent hashing, for sharding caches
+// or other state keyed by record UUIDs.  Adding or removing a member only
+// moves the UUIDs owned by that member.  Each member receives a share of the
+// UUIDs proportional to its weight.
+//
+// The zero value is an empty Ring ready to use.  A Ring is safe for
+// concurrent use by multiple goroutines.
+type Ring struct {
+	mu      sync.RWMutex
+	weights map[string]int // protected by mu
+	points  []ringPoint    // protected by mu, sorted by hash
+}
+
+type ringPoint struct {
+	hash   uint64
+	member string
+}
+
+// Add adds member to r with weight, or changes its weight if it was already
+// added.  A weight of 0 or less removes member.
+func (r *Ring) Add(member string, weight int) {
+	defer r.mu.Unlock()
+	r.mu.Lock()
+	if weight <= 0 {
+		delete(r.weights, member)
+	} else {
+		if r.weights == nil {
+			r.weights = make(map[string]int)
+		}
+		r.weights[member] = weight
+	}
+	r.build()
+}
+
+// Remove removes member from r.
+func (r *Ring) Remove(member string) {
+	r.Add(member, 0)
+}
+
+// Members returns the number of members of r.
+func (r *Ring) Members() int {
+	defer r.mu.RUnlock()
+	r.mu.RLock()
+	return len(r.weights)
+}
+
+// Owner returns the member of r owning uuid.  It returns false if r has no
+// members.
+func (r *Ring) Owner(uuid UUID) (member string, ok bool) {
+	defer r.mu.RUnlock()
+	r.mu.RLock()
+	if len(r.points) == 0 {
+		return "", false
+	}
+	h1, _ := filterHashes(uuid)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= h1
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.points[i].member, true
+}
+
+// build rebuilds the points of r from its weights.  r.mu must be held.
+func (r *Ring) build() {
+	n := 0
+	for _, w := range r.weights {
+		n += w * ringPointsPerWeight
+	}
+	points := make([]ringPoint, 0, n)
+	for member, w := range r.weights {
+		h := fnv.New64a()
+		h.Write([]byte(member))
+		seed := h.Sum64()
+		for i := 0; i < w*ringPointsPerWeight; i++ {
+			points = append(points, ringPoint{mix64(seed + uint64(i)*0x9e3779b97f4a7c15), member})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		return points[i].member < points[j].member
+	})
+	r.points = points
+}
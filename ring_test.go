@@ -0,0 +1,49 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRing(t *testing.T) {
+	var r Ring
+	if _, ok := r.Owner(NameSpaceDNS); ok {
+		t.Errorf("empty Ring has an owner")
+	}
+	r.Add("a", 1)
+	r.Add("b", 1)
+	r.Add("c", 2)
+	if n := r.Members(); n != 3 {
+		t.Errorf("Members() = %d, want 3", n)
+	}
+
+	const n = 20000
+	uuids := make([]UUID, n)
+	owners := make([]string, n)
+	counts := map[string]int{}
+	for i := range uuids {
+		uuids[i] = Must(NewV7())
+		owners[i], _ = r.Owner(uuids[i])
+		counts[owners[i]]++
+	}
+	for member, want := range map[string]float64{"a": 0.25, "b": 0.25, "c": 0.5} {
+		if got := float64(counts[member]) / n; math.Abs(got-want) > 0.05 {
+			t.Errorf("member %s owns %.3f of the UUIDs, want about %.2f", member, got, want)
+		}
+	}
+
+	r.Remove("b")
+	for i, uuid := range uuids {
+		got, _ := r.Owner(uuid)
+		if owners[i] != "b" && got != owners[i] {
+			t.Fatalf("removing b moved %s from %s to %s", uuid, owners[i], got)
+		}
+		if got == "b" {
+			t.Fatalf("%s is owned by removed member b", uuid)
+		}
+	}
+}
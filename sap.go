@@ -0,0 +1,27 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/hex"
+
+// FormatSAP returns uuid in the form SAP systems use for GUIDs stored as
+// RAW16, 32 upper case hex digits without hyphens:
+// XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.
+func (uuid UUID) FormatSAP() string {
+	var buf [32]byte
+	hex.Encode(buf[:], uuid[:])
+	upperHex(buf[:])
+	return string(buf[:])
+}
+
+// ParseSAP parses s in the form returned by FormatSAP.  Unlike Parse, which
+// also accepts 32 hex digits, ParseSAP rejects all other forms.  Lower case
+// hex digits are accepted.
+func ParseSAP(s string) (UUID, error) {
+	if len(s) != 32 {
+		return Nil, invalidLengthError{len(s)}
+	}
+	return Parse(s)
+}
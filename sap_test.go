@@ -0,0 +1,24 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestSAP(t *testing.T) {
+	const want = "6BA7B8109DAD11D180B400C04FD430C8"
+	if s := NameSpaceDNS.FormatSAP(); s != want {
+		t.Errorf("FormatSAP() = %s, want %s", s, want)
+	}
+	for _, s := range []string{want, "6ba7b8109dad11d180b400c04fd430c8"} {
+		if uuid, err := ParseSAP(s); err != nil || uuid != NameSpaceDNS {
+			t.Errorf("ParseSAP(%s) = %s, %v, want %s", s, uuid, err, NameSpaceDNS)
+		}
+	}
+	for _, s := range []string{"", NameSpaceDNS.String(), "6BA7B8109DAD11D180B400C04FD430CX"} {
+		if _, err := ParseSAP(s); err == nil {
+			t.Errorf("ParseSAP(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A ScanError records a line that a Scanner failed to parse.
+type ScanError struct {
+	Line int    // line number, starting at 1
+	Text string // text of the line
+	Err  error  // error returned by ParseFormat
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("line %d: %q: %v", e.Line, e.Text, e.Err)
+}
+
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// A Scanner reads newline delimited UUIDs, such as ID lists from files and
+// pipes, using the interface of bufio.Scanner:
+//
+//	s := uuid.NewScanner(os.Stdin, uuid.FormatCanonical)
+//	for s.Scan() {
+//		use(s.UUID())
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+//
+// Leading and trailing white space is ignored, as are empty lines.  Scanning
+// stops at the first line that is not a UUID in one of the forms of the
+// Scanner's Format, and Err returns a *ScanError, or at the first line longer
+// than 4096 bytes, and Err returns bufio.ErrTooLong.
+type Scanner struct {
+	s      *bufio.Scanner
+	format Format
+	line   int
+	uuid   UUID
+	err    error
+}
+
+// NewScanner returns a Scanner reading from r that accepts the forms in
+// format.  Use FormatCanonical for strict input and FormatAny to accept
+// everything Parse does.
+func NewScanner(r io.Reader, format Format) *Scanner {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, maxScanLine)
+	return &Scanner{s: s, format: format}
+}
+
+// maxScanLine is the length of the longest line read by a Scanner, leaving
+// ample room for white space around a UUID.
+const maxScanLine = 4096
+
+// Scan advances the Scanner to the next UUID, which is then available through
+// the UUID method.  It returns false when the scan stops, either by reaching
+// the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for s.s.Scan() {
+		s.line++
+		text := strings.TrimSpace(s.s.Text())
+		if text == "" {
+			continue
+		}
+		uuid, err := ParseFormat(text, s.format)
+		if err != nil {
+			s.uuid = Nil
+			s.err = &ScanError{Line: s.line, Text: text, Err: err}
+			return false
+		}
+		s.uuid = uuid
+		return true
+	}
+	s.uuid = Nil
+	s.err = s.s.Err()
+	return false
+}
+
+// UUID returns the most recent UUID read by a call to Scan.
+func (s *Scanner) UUID() UUID {
+	return s.uuid
+}
+
+// Line returns the line number of the most recent UUID read by a call to
+// Scan, or of the line that stopped the scan.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Err returns the first error encountered by the Scanner, or nil if the end
+// of the input was reached.
+func (s *Scanner) Err() error {
+	return s.err
+}
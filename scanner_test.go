@@ -0,0 +1,109 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	const s = "f47ac10b-58cc-0372-8567-0e02b2c3d479"
+	want := MustParse(s)
+	for _, tt := range []struct {
+		in     string
+		format Format
+		err    error
+	}{
+		{s, FormatCanonical, nil},
+		{s, 0, nil},
+		{"urn:uuid:" + s, FormatURN, nil},
+		{"urn:uuid:" + s, FormatCanonical | FormatHex, ErrFormatNotAllowed},
+		{"{" + s + "}", FormatBraced, nil},
+		{"{" + s + "}", FormatCanonical, ErrFormatNotAllowed},
+		{"(" + s + ")", FormatBraced, ErrFormatNotAllowed},
+		{"(" + s + ")", FormatParens, nil},
+		{"(" + s + ")", FormatCanonical | FormatBraced | FormatParens, nil},
+		{"{" + s + ")", FormatBraced | FormatParens, ErrInvalidBracketedFormat},
+		{"[" + s + "]", FormatAny, ErrInvalidBracketedFormat},
+		{"f47ac10b58cc037285670e02b2c3d479", FormatAny, nil},
+		{"f47ac10b58cc037285670e02b2c3d479", FormatCanonical, ErrFormatNotAllowed},
+		{s[1:], FormatAny, ErrInvalidLength},
+		{"urn:uuid:" + s[:35] + "x", FormatURN, ErrInvalidUUIDFormat},
+	} {
+		got, err := ParseFormat(tt.in, tt.format)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ParseFormat(%q, %d) got error %v, want %v", tt.in, tt.format, err, tt.err)
+		}
+		if err == nil && got != want {
+			t.Errorf("ParseFormat(%q, %d) got %s, want %s", tt.in, tt.format, got, want)
+		}
+	}
+}
+
+func TestScanner(t *testing.T) {
+	ids := []UUID{NameSpaceDNS, NameSpaceURL, NameSpaceOID}
+	in := ids[0].String() + "\r\n\n  " + ids[1].URN() + "\t\n" + ids[2].String()
+	s := NewScanner(strings.NewReader(in), FormatCanonical|FormatURN)
+	var got []UUID
+	var lines []int
+	for s.Scan() {
+		got = append(got, s.UUID())
+		lines = append(lines, s.Line())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("got %d UUIDs, want %d", len(got), len(ids))
+	}
+	for i := range ids {
+		if got[i] != ids[i] {
+			t.Errorf("#%d: got %s, want %s", i, got[i], ids[i])
+		}
+	}
+	if lines[1] != 3 || lines[2] != 4 {
+		t.Errorf("got lines %v, want [1 3 4]", lines)
+	}
+	if s.Scan() {
+		t.Errorf("Scan returned true after the end of the input")
+	}
+}
+
+func TestScannerError(t *testing.T) {
+	in := NameSpaceDNS.String() + "\n" + "f47ac10b58cc037285670e02b2c3d479\n" + NameSpaceURL.String()
+	s := NewScanner(strings.NewReader(in), FormatCanonical)
+	n := 0
+	for s.Scan() {
+		n++
+	}
+	if n != 1 {
+		t.Errorf("scanned %d UUIDs, want 1", n)
+	}
+	var serr *ScanError
+	if !errors.As(s.Err(), &serr) {
+		t.Fatalf("Err() = %v, want a *ScanError", s.Err())
+	}
+	if serr.Line != 2 || !errors.Is(serr, ErrFormatNotAllowed) {
+		t.Errorf("got error %v on line %d, want %v on line 2", serr.Err, serr.Line, ErrFormatNotAllowed)
+	}
+	if s.Scan() {
+		t.Errorf("Scan returned true after an error")
+	}
+}
+
+func TestScannerLongLine(t *testing.T) {
+	in := NameSpaceDNS.String() + "\n" + strings.Repeat(" ", maxScanLine) + NameSpaceURL.String()
+	s := NewScanner(strings.NewReader(in), FormatAny)
+	n := 0
+	for s.Scan() {
+		n++
+	}
+	if n != 1 || s.Err() != bufio.ErrTooLong {
+		t.Errorf("scanned %d UUIDs with error %v, want 1 and %v", n, s.Err(), bufio.ErrTooLong)
+	}
+}
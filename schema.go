@@ -0,0 +1,44 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/json"
+
+// JSONSchemaPattern is a regular expression matching the string form of a
+// UUID as produced by String and MarshalText with the default Style.
+const JSONSchemaPattern = "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+
+// JSONSchema returns the JSON Schema, and OpenAPI schema, of a UUID:
+//
+//	{"type": "string", "format": "uuid", "pattern": JSONSchemaPattern,
+//	 "minLength": 36, "maxLength": 36}
+//
+// Each call returns a new map that may be modified by the caller.
+func JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":      "string",
+		"format":    "uuid",
+		"pattern":   JSONSchemaPattern,
+		"minLength": 36,
+		"maxLength": 36,
+	}
+}
+
+// JSONSchemaBytes returns the JSON encoding of JSONSchema.  It implements the
+// RawExposer interface of schema generators such as
+// github.com/swaggest/jsonschema-go, so struct fields of type UUID are
+// reflected with the constraints of a UUID rather than as an array of 16
+// integers.
+func (UUID) JSONSchemaBytes() ([]byte, error) {
+	return json.Marshal(JSONSchema())
+}
+
+// JSONSchemaBytes is like UUID.JSONSchemaBytes but also allows null, as
+// MarshalJSON encodes an invalid NullUUID as null.
+func (NullUUID) JSONSchemaBytes() ([]byte, error) {
+	s := JSONSchema()
+	s["type"] = []string{"string", "null"}
+	return json.Marshal(s)
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	re := regexp.MustCompile(JSONSchemaPattern)
+	for _, s := range []string{NameSpaceDNS.String(), "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", Nil.String()} {
+		if !re.MatchString(s) {
+			t.Errorf("pattern does not match %s", s)
+		}
+	}
+	for _, s := range []string{NameSpaceDNS.URN(), "6ba7b8109dad11d180b400c04fd430c8", " " + NameSpaceDNS.String()} {
+		if re.MatchString(s) {
+			t.Errorf("pattern matches %s", s)
+		}
+	}
+
+	var u UUID
+	data, err := u.JSONSchemaBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["type"] != "string" || got["format"] != "uuid" || got["pattern"] != JSONSchemaPattern {
+		t.Errorf("UUID schema = %s", data)
+	}
+
+	var nu NullUUID
+	if data, err = nu.JSONSchemaBytes(); err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	json.Unmarshal(data, &got)
+	if want := []interface{}{"string", "null"}; !reflect.DeepEqual(got["type"], want) {
+		t.Errorf("NullUUID schema type = %v, want %v", got["type"], want)
+	}
+}
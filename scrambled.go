@@ -0,0 +1,151 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// checkPrefixBits returns an error if prefixBits is not between 1 and 12.
+func checkPrefixBits(prefixBits int) error {
+	if prefixBits < 1 || prefixBits > 12 {
+		return fmt.Errorf("invalid scrambled prefix bits: %d", prefixBits)
+	}
+	return nil
+}
+
+// NewV8Scrambled returns a scrambled Version 8 UUID with prefixBits random
+// leading bits, which must be between 1 and 12, based on the current time.
+// The random bits are read as NewRandom does.  Successive scrambled UUIDs with
+// the same prefixBits are strictly increasing as ordered by CompareScrambled.
+//
+// Scrambled UUIDs are for hash partitioned stores such as DynamoDB or
+// Bigtable, where Version 7 UUIDs, which all start with the current time,
+// send every insert to the same hot partition.  The first prefixBits bits (1
+// to 12) of a scrambled UUID are random, spreading inserts over 2^prefixBits
+// key ranges, followed by the 48 bit Unix time in milliseconds of Version 7
+// UUIDs:
+//
+//	 0                   1                   2                   3
+//	 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|prefix |               unix_ts_ms (high bits)                  |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|   unix_ts_ms (high bits)      |  ver  | ms low|      seq      |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|var|                        rand_b                             |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//	|                            rand_b                             |
+//	+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+//
+// The prefixBits low bits of the time that do not fit before the version are
+// stored in rand_a, followed by a sequence number of 12-prefixBits bits that
+// holds a fraction of the millisecond, as in Version 7 UUIDs.  The byte order
+// of scrambled UUIDs with the same prefix is their time order, so
+// ScrambledBounds can be used for range scans in each of the key ranges,
+// while CompareScrambled orders scrambled UUIDs of all prefixes by time.
+//
+// The prefixBits used to generate and to read scrambled UUIDs must match.
+func NewV8Scrambled(prefixBits int) (UUID, error) {
+	if err := checkPrefixBits(prefixBits); err != nil {
+		return Nil, err
+	}
+	uuid, err := NewRandom()
+	if err != nil {
+		return Nil, err
+	}
+	milli, seq := getScrambledTime(prefixBits)
+	prefix := uint64(binary.BigEndian.Uint16(uuid[:2])) >> (16 - prefixBits)
+	putScrambled(&uuid, prefixBits, prefix, uint64(milli), uint64(seq))
+	return uuid, nil
+}
+
+// lastScrambled is the last time returned by getScrambledTime for each number
+// of prefix bits, stored as milliseconds followed by 12-prefixBits bits of
+// sequence number.  It is protected by timeMu.
+var lastScrambled [13]int64
+
+// getScrambledTime is like getV7Time but returns a sequence number of
+// 12-prefixBits bits.
+func getScrambledTime(prefixBits int) (milli, seq int64) {
+	bits := uint(12 - prefixBits)
+	timeMu.Lock()
+	defer timeMu.Unlock()
+	nano := now().UnixNano()
+	milli = nano / nanoPerMilli
+	t := milli<<bits + (nano-milli*nanoPerMilli)<<bits/nanoPerMilli
+	if last := &lastScrambled[prefixBits]; t <= *last {
+		t = *last + 1
+	}
+	lastScrambled[prefixBits] = t
+	return t >> bits, t & (1<<bits - 1)
+}
+
+// putScrambled stores the fields of a scrambled UUID in the first 8 bytes of
+// uuid and sets the variant.
+func putScrambled(uuid *UUID, prefixBits int, prefix, milli, seq uint64) {
+	p := uint(prefixBits)
+	hi := prefix << (64 - p)
+	hi |= (milli >> p) << 16
+	hi |= 0x8 << 12 // Version 8
+	hi |= (milli & (1<<p - 1)) << (12 - p)
+	hi |= seq & (1<<(12-p) - 1)
+	binary.BigEndian.PutUint64(uuid[:8], hi)
+	uuid[8] = (uuid[8] & 0x3f) | 0x80 // Variant is 10
+}
+
+// scrambledKey returns the Unix time in milliseconds of the scrambled UUID
+// uuid followed by its sequence bits.
+func scrambledKey(uuid UUID, prefixBits int) uint64 {
+	p := uint(prefixBits)
+	hi := binary.BigEndian.Uint64(uuid[:8])
+	milli := (hi>>16)&(1<<(48-p)-1)<<p | (hi>>(12-p))&(1<<p-1)
+	return milli<<(12-p) | hi&(1<<(12-p)-1)
+}
+
+// ScrambledTime returns the time of the scrambled UUID uuid generated with
+// prefixBits random leading bits.
+func ScrambledTime(uuid UUID, prefixBits int) time.Time {
+	milli := int64(scrambledKey(uuid, prefixBits) >> (12 - uint(prefixBits)))
+	return time.Unix(milli/1000, milli%1000*nanoPerMilli)
+}
+
+// CompareScrambled compares the scrambled UUIDs a and b generated with
+// prefixBits random leading bits by time, ignoring the prefix.  The result is
+// 0 if a == b, -1 if a < b, and +1 if a > b.  UUIDs with the same time and
+// sequence bits are ordered by their remaining bits and then by prefix.
+func CompareScrambled(a, b UUID, prefixBits int) int {
+	ka, kb := scrambledKey(a, prefixBits), scrambledKey(b, prefixBits)
+	switch {
+	case ka < kb:
+		return -1
+	case ka > kb:
+		return 1
+	}
+	if c := bytes.Compare(a[8:], b[8:]); c != 0 {
+		return c
+	}
+	return Compare(a, b)
+}
+
+// ScrambledBounds returns the smallest and the largest scrambled UUID with
+// prefixBits leading bits prefix and a time between from and to, inclusive,
+// in milliseconds.  A range scan between min and max returns the UUIDs of the
+// key range of prefix in that time span; scanning all 2^prefixBits key ranges
+// returns all of them.
+func ScrambledBounds(prefix uint64, prefixBits int, from, to time.Time) (min, max UUID) {
+	p := uint(prefixBits)
+	prefix &= 1<<p - 1
+	putScrambled(&min, prefixBits, prefix, uint64(from.UnixNano()/nanoPerMilli), 0)
+	putScrambled(&max, prefixBits, prefix, uint64(to.UnixNano()/nanoPerMilli), 1<<(12-p)-1)
+	max[8] = 0xbf
+	for i := 9; i < 16; i++ {
+		max[i] = 0xff
+	}
+	return min, max
+}
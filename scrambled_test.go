@@ -0,0 +1,80 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"sort"
+	"testing"
+	"time"
+)
+
+func scrambledPrefix(uuid UUID, prefixBits int) uint64 {
+	return uint64(binary.BigEndian.Uint16(uuid[:2])) >> (16 - prefixBits)
+}
+
+func TestNewV8Scrambled(t *testing.T) {
+	for _, p := range []int{0, 13} {
+		if _, err := NewV8Scrambled(p); err == nil {
+			t.Errorf("NewV8Scrambled(%d) succeeded", p)
+		}
+	}
+	for _, p := range []int{1, 4, 12} {
+		before := time.Now().Truncate(time.Millisecond)
+		uuids := make([]UUID, 1000)
+		prefixes := map[uint64]bool{}
+		for i := range uuids {
+			uuids[i] = Must(NewV8Scrambled(p))
+			prefixes[scrambledPrefix(uuids[i], p)] = true
+			if v := uuids[i].Version(); v != 8 {
+				t.Fatalf("NewV8Scrambled(%d) returned version %d", p, v)
+			}
+			if uuids[i].Variant() != RFC4122 {
+				t.Fatalf("NewV8Scrambled(%d) returned variant %s", p, uuids[i].Variant())
+			}
+		}
+		after := time.Now()
+		if len(prefixes) < 2 {
+			t.Errorf("NewV8Scrambled(%d) prefixes are not random", p)
+		}
+		for i := 1; i < len(uuids); i++ {
+			if CompareScrambled(uuids[i-1], uuids[i], p) >= 0 {
+				t.Fatalf("NewV8Scrambled(%d) #%d: %s not after %s", p, i, uuids[i], uuids[i-1])
+			}
+		}
+		if ts := ScrambledTime(uuids[0], p); ts.Before(before) || ts.After(after) {
+			t.Errorf("ScrambledTime(%s, %d) = %v, want between %v and %v", uuids[0], p, ts, before, after)
+		}
+	}
+}
+
+func TestScrambledBounds(t *testing.T) {
+	const p = 4
+	uuids := make([]UUID, 500)
+	for i := range uuids {
+		uuids[i] = Must(NewV8Scrambled(p))
+	}
+	first, last := ScrambledTime(uuids[0], p), ScrambledTime(uuids[len(uuids)-1], p)
+	n := 0
+	for prefix := uint64(0); prefix < 1<<p; prefix++ {
+		min, max := ScrambledBounds(prefix, p, first, last)
+		var inRange []UUID
+		for _, uuid := range uuids {
+			if Compare(min, uuid) <= 0 && Compare(uuid, max) <= 0 {
+				inRange = append(inRange, uuid)
+				if scrambledPrefix(uuid, p) != prefix {
+					t.Errorf("%s in the range of prefix %d", uuid, prefix)
+				}
+			}
+		}
+		if !sort.SliceIsSorted(inRange, func(i, j int) bool { return Compare(inRange[i], inRange[j]) < 0 }) {
+			t.Errorf("prefix %d: byte order is not time order", prefix)
+		}
+		n += len(inRange)
+	}
+	if n != len(uuids) {
+		t.Errorf("bounds cover %d of %d UUIDs", n, len(uuids))
+	}
+}
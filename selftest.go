@@ -0,0 +1,65 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrSelfTest matches, using errors.Is, the errors returned by SelfTest when
+// the source of randomness fails its checks.
+var ErrSelfTest = errors.New("UUID random source failed self test")
+
+// selfTestSize is the number of UUIDs generated by SelfTest.
+const selfTestSize = 1024
+
+// SelfTest generates a sample of Version 4 UUIDs with NewRandom, and thus with
+// the source of randomness set by SetRand and the pool set by EnableRandPool,
+// and returns an error if the sample has duplicates or a random bit is set
+// significantly more or less often than half of the time.  It is meant to be
+// called at startup, to catch degenerate sources of randomness wired in by
+// mistake, such as a reader of zeros:
+//
+//	if err := uuid.SelfTest(); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// The checks are basic and detect broken sources, not weak ones: a
+// pseudo-random generator of a fixed seed, whose output is the same in every
+// run, passes them.  A sound source fails them with a probability of less
+// than one in a million.
+// Errors of the source itself are returned as is.
+func SelfTest() error {
+	return selfTest(NewRandom)
+}
+
+func selfTest(newRandom func() (UUID, error)) error {
+	var a analyzer
+	for i := 0; i < selfTestSize; i++ {
+		u, err := newRandom()
+		if err != nil {
+			return err
+		}
+		a.add(u)
+	}
+	a.finish()
+	if d := a.stats.Duplicates; d > 0 {
+		return fmt.Errorf("%w: %d duplicates in %d UUIDs", ErrSelfTest, d, selfTestSize)
+	}
+	// Each random bit is set a binomial number of times, of mean n/2 and
+	// standard deviation sqrt(n)/2.  Six standard deviations are allowed.
+	tolerance := int(3 * math.Sqrt(selfTestSize))
+	for i, n := range a.n {
+		if n == 0 {
+			continue
+		}
+		if d := a.ones[i] - n/2; d > tolerance || d < -tolerance {
+			return fmt.Errorf("%w: bit %d set in %d of %d UUIDs", ErrSelfTest, i, a.ones[i], n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// biasedReader returns random bytes with the low bit of every byte set.
+type biasedReader struct{ r *rand.Rand }
+
+func (b biasedReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	for i := range p[:n] {
+		p[i] |= 1
+	}
+	return n, err
+}
+
+func TestSelfTest(t *testing.T) {
+	defer Restore(Snapshot())
+	DisableRandPool()
+	SetRand(nil)
+	if err := SelfTest(); err != nil {
+		t.Errorf("SelfTest() with crypto/rand: %v", err)
+	}
+
+	for name, r := range map[string]io.Reader{
+		"zero":   zeroReader{},
+		"fixed":  fakeRand{},
+		"biased": biasedReader{rand.New(rand.NewSource(1))},
+		"cycle":  bytes.NewReader(bytes.Repeat(testUUID[:], selfTestSize)),
+	} {
+		SetRand(r)
+		if err := SelfTest(); !errors.Is(err, ErrSelfTest) {
+			t.Errorf("SelfTest() with %s reader returned %v, want %v", name, err, ErrSelfTest)
+		}
+	}
+
+	SetRand(bytes.NewReader(nil))
+	if err := SelfTest(); err != io.EOF {
+		t.Errorf("SelfTest() with an empty reader returned %v, want %v", err, io.EOF)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"sync"
+)
+
+// SnowflakeEpoch is the epoch of Snowflake IDs in milliseconds since the Unix
+// epoch (2010-11-04T01:42:54.657Z).
+const SnowflakeEpoch = 1288834974657
+
+// snowflakeTag marks the Version 8 UUIDs of FromSnowflake.
+const snowflakeTag = 0x5346 // "SF"
+
+var (
+	// ErrWorkerID is returned by NewSnowflakeGenerator for worker IDs
+	// that do not fit in 10 bits.
+	ErrWorkerID = errors.New("Snowflake worker ID must be less than 1024")
+
+	// ErrSnowflakeTime is returned by SnowflakeGenerator.Next when the
+	// current time cannot be represented in a Snowflake ID.
+	ErrSnowflakeTime = errors.New("time out of range for Snowflake IDs")
+)
+
+// A SnowflakeGenerator generates 64 bit Snowflake IDs, consisting of a 41 bit
+// timestamp in milliseconds since SnowflakeEpoch, a 10 bit worker ID and a
+// 12 bit sequence number, for systems that must emit both Snowflake IDs and
+// UUIDs, for instance during a migration.  The IDs of a SnowflakeGenerator are
+// strictly increasing, as are the UUIDs of package level NewV7: if more than
+// 4096 IDs are generated in a millisecond the timestamp is advanced.
+//
+// A SnowflakeGenerator is safe for concurrent use by multiple goroutines.
+type SnowflakeGenerator struct {
+	worker int64
+
+	mu   sync.Mutex
+	last int64 // protected by mu, timestamp << 12 | sequence of the last ID
+}
+
+// NewSnowflakeGenerator returns a SnowflakeGenerator for workerID, which must
+// be less than 1024 and unique among the workers generating IDs.
+func NewSnowflakeGenerator(workerID uint16) (*SnowflakeGenerator, error) {
+	if workerID >= 1<<10 {
+		return nil, ErrWorkerID
+	}
+	return &SnowflakeGenerator{worker: int64(workerID)}, nil
+}
+
+// Next returns a new Snowflake ID.
+func (s *SnowflakeGenerator) Next() (int64, error) {
+	milli := timeNow().UnixNano()/nanoPerMilli - SnowflakeEpoch
+	s.mu.Lock()
+	now := milli << 12
+	if now <= s.last {
+		now = s.last + 1
+	}
+	if now < 0 || now>>12 >= 1<<41 {
+		s.mu.Unlock()
+		return 0, ErrSnowflakeTime
+	}
+	s.last = now
+	s.mu.Unlock()
+	return now>>12<<22 | s.worker<<12 | now&0xfff, nil
+}
+
+// NextUUID returns a new Snowflake ID wrapped in a UUID by FromSnowflake.
+func (s *SnowflakeGenerator) NextUUID() (UUID, error) {
+	id, err := s.Next()
+	if err != nil {
+		return Nil, err
+	}
+	return FromSnowflake(id), nil
+}
+
+// FromSnowflake returns a Version 8 UUID holding the Snowflake ID id.  The
+// UUID starts with the timestamp of id in milliseconds since the Unix epoch,
+// followed by its sequence number, so the UUIDs sort in the same order as
+// Version 7 UUIDs of the same time.  The remaining bits hold a tag marking
+// the layout and the worker ID of id.  UUID.Snowflake returns id.
+//
+// The layout is
+//
+//	unix_ts_ms 48 bits, Snowflake timestamp + SnowflakeEpoch
+//	ver         4 bits, 8
+//	sequence   12 bits
+//	var         2 bits, 0b10
+//	tag        16 bits, 0x5346
+//	worker     10 bits
+//	zero       36 bits
+func FromSnowflake(id int64) UUID {
+	var uuid UUID
+	milli := id>>22 + SnowflakeEpoch
+	uuid, _ = SetBits(uuid, 0, 48, uint64(milli))
+	uuid, _ = SetBits(uuid, 52, 12, uint64(id&0xfff))
+	uuid, _ = SetBits(uuid, 66, 16, snowflakeTag)
+	uuid, _ = SetBits(uuid, 82, 10, uint64(id>>12&0x3ff))
+	uuid[6] = uuid[6]&0x0f | 0x80 // Version 8
+	uuid[8] = uuid[8]&0x3f | 0x80 // Variant is 10
+	return uuid
+}
+
+// Snowflake returns the Snowflake ID held by uuid, a UUID returned by
+// FromSnowflake.  It returns false if uuid was not returned by FromSnowflake.
+func (uuid UUID) Snowflake() (int64, bool) {
+	if uuid.Version() != 8 || uuid.Variant() != RFC4122 ||
+		GetBits(uuid, 66, 16) != snowflakeTag || GetBits(uuid, 92, 36) != 0 {
+		return 0, false
+	}
+	ts := int64(GetBits(uuid, 0, 48)) - SnowflakeEpoch
+	if ts < 0 || ts >= 1<<41 {
+		return 0, false
+	}
+	return ts<<22 | int64(GetBits(uuid, 82, 10))<<12 | int64(GetBits(uuid, 52, 12)), true
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnowflakeGenerator(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	if _, err := NewSnowflakeGenerator(1024); err != ErrWorkerID {
+		t.Errorf("NewSnowflakeGenerator(1024) got error %v, want %v", err, ErrWorkerID)
+	}
+	s, err := NewSnowflakeGenerator(513)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id1, err := s.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms := id1>>22 + SnowflakeEpoch; ms != now.UnixNano()/1e6 {
+		t.Errorf("timestamp %d, want %d", ms, now.UnixNano()/1e6)
+	}
+	if w := id1 >> 12 & 0x3ff; w != 513 {
+		t.Errorf("worker %d, want 513", w)
+	}
+	for i := 0; i < 5000; i++ {
+		id2, _ := s.Next()
+		if id2 <= id1 {
+			t.Fatalf("monotonicity failed at #%d: %d <= %d", i, id2, id1)
+		}
+		id1 = id2
+	}
+
+	now = time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := s.Next(); err != ErrSnowflakeTime {
+		t.Errorf("Next in 2200 got error %v, want %v", err, ErrSnowflakeTime)
+	}
+}
+
+func TestFromSnowflake(t *testing.T) {
+	const id = 1541815603606036480 // Twitter status ID from 2022
+	uuid := FromSnowflake(id)
+	if v := uuid.Version(); v != 8 {
+		t.Errorf("Version() = %d, want 8", v)
+	}
+	if v := uuid.Variant(); v != RFC4122 {
+		t.Errorf("Variant() = %v, want RFC4122", v)
+	}
+	if got := time.Unix(0, int64(GetBits(uuid, 0, 48))*1e6).UTC(); got.Year() != 2022 {
+		t.Errorf("UUID time %v, want a time in 2022", got)
+	}
+	if got, ok := uuid.Snowflake(); !ok || got != id {
+		t.Errorf("Snowflake() = %d, %v, want %d, true", got, ok, id)
+	}
+	for _, u := range []UUID{Nil, Must(NewV7()), MustParse("017f22e2-79b0-8cc3-98c4-dc0c0c07398f")} {
+		if _, ok := u.Snowflake(); ok {
+			t.Errorf("%s is not a Snowflake UUID", u)
+		}
+	}
+
+	s, _ := NewSnowflakeGenerator(1)
+	u1 := Must(s.NextUUID())
+	u2 := Must(s.NextUUID())
+	if Compare(u1, u2) >= 0 {
+		t.Errorf("Snowflake UUIDs not increasing: %s >= %s", u1, u2)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// SonyflakeEpoch is the default epoch of Sonyflake IDs in milliseconds since
+// the Unix epoch (2014-09-01T00:00:00Z).
+const SonyflakeEpoch = 1409529600000
+
+// FromSonyflake returns the Version 7 UUID of the Sonyflake ID id
+// (github.com/sony/sonyflake) generated with the default SonyflakeEpoch.  The
+// 39 bit timestamp of id, in units of 10 milliseconds, becomes the
+// millisecond timestamp of the UUID, the 8 bit sequence number the upper
+// bits of rand_a and the 16 bit machine ID the upper bits of rand_b, so the
+// UUIDs of Sonyflake IDs sort by time.  The remaining bits are 0.  The
+// conversion is lossless: ToSonyflake returns id.
+func FromSonyflake(id uint64) UUID {
+	var uuid UUID
+	milli := id>>24*10 + SonyflakeEpoch
+	uuid, _ = SetBits(uuid, 0, 48, milli)
+	uuid, _ = SetBits(uuid, 52, 8, id>>16)
+	uuid, _ = SetBits(uuid, 66, 16, id)
+	uuid[6] = uuid[6]&0x0f | 0x70 // Version 7
+	uuid[8] = uuid[8]&0x3f | 0x80 // Variant is 10
+	return uuid
+}
+
+// ToSonyflake returns the Sonyflake ID of uuid, a Version 7 UUID, as
+// FromSonyflake stores it.  For UUIDs not returned by FromSonyflake the
+// conversion is lossy: the timestamp is truncated to 10 milliseconds and only
+// 8 bits of rand_a and 16 bits of rand_b are kept.  ToSonyflake returns false
+// if uuid is not a Version 7 UUID or its time is before SonyflakeEpoch or
+// beyond the range of Sonyflake IDs.
+func (uuid UUID) ToSonyflake() (uint64, bool) {
+	if uuid.Version() != 7 {
+		return 0, false
+	}
+	milli := GetBits(uuid, 0, 48)
+	if milli < SonyflakeEpoch || (milli-SonyflakeEpoch)/10 >= 1<<39 {
+		return 0, false
+	}
+	return (milli-SonyflakeEpoch)/10<<24 | GetBits(uuid, 52, 8)<<16 | GetBits(uuid, 66, 16), true
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeSortable(t *testing.T) {
+	for _, tt := range []struct {
+		uuid UUID
+		want string
+	}{
+		{Nil, "00000000000000000000000000"},
+		{Max, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		{NameSpaceDNS, EncodeAlphabet(NameSpaceDNS, crockford)},
+	} {
+		s := tt.uuid.EncodeSortable()
+		if s != tt.want {
+			t.Errorf("%s.EncodeSortable() = %s, want %s", tt.uuid, s, tt.want)
+		}
+		for _, in := range []string{s, strings.ToLower(s)} {
+			if u, err := ParseSortable(in); err != nil || u != tt.uuid {
+				t.Errorf("ParseSortable(%s) = %s, %v, want %s", in, u, err, tt.uuid)
+			}
+		}
+	}
+
+	last := Must(NewV7())
+	for i := 0; i < 1000; i++ {
+		u := Must(NewV7())
+		if a, b := last.EncodeSortable(), u.EncodeSortable(); a >= b {
+			t.Fatalf("encodings of %s and %s do not sort: %s >= %s", last, u, a, b)
+		}
+		last = u
+	}
+
+	for _, bad := range []string{"", "0000000000000000000000000", "80000000000000000000000000", "0000000000000000000000000U", "000000000000000000000000I0"} {
+		if _, err := ParseSortable(bad); err == nil {
+			t.Errorf("ParseSortable(%q) succeeded", bad)
+		}
+	}
+}
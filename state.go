@@ -0,0 +1,80 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// State is the state of UUID generation that is persisted by a StateStore.
+// Fields may be added to State in the future; a StateStore must preserve all
+// of them.
+type State struct {
+	// NodeID is a randomly generated Node ID, see InterfaceNodeProvider.
+	NodeID []byte `json:",omitempty"`
+
+	// V7Milli and V7Seq bound the Version 7 UUIDs issued by a Generator
+	// created with WithStateStore: the time, in milliseconds since 1 Jan
+	// 1970, and the counter in rand_a of its UUIDs never exceed them.
+	V7Milli int64 `json:",omitempty"`
+	V7Seq   int64 `json:",omitempty"`
+}
+
+// A StateStore persists State across restarts of the program.
+type StateStore interface {
+	// Load returns the saved State, or the zero State if none was saved.
+	Load() (State, error)
+
+	// Save saves s.
+	Save(s State) error
+}
+
+// A FileStateStore is a StateStore saving the State as JSON in the file
+// named Path.
+type FileStateStore struct {
+	Path string
+}
+
+// Load implements StateStore.
+func (f FileStateStore) Load() (State, error) {
+	var s State
+	data, err := os.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	err = json.Unmarshal(data, &s)
+	return s, err
+}
+
+// Save implements StateStore.  The file is replaced atomically, so a crash
+// cannot leave a partially written State behind.
+func (f FileStateStore) Save(s State) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
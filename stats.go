@@ -0,0 +1,115 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"math"
+	"time"
+)
+
+// Stats describes a set of UUIDs, as returned by Analyze.
+type Stats struct {
+	Count         int       // number of UUIDs
+	Duplicates    int       // number of UUIDs equal to an earlier one
+	Versions      [16]int   // number of RFC 9562 variant UUIDs of each version
+	OtherVariants int       // number of UUIDs of other variants, Nil and Max included
+	Oldest        time.Time // earliest time of the version 1, 6 and 7 UUIDs
+	Newest        time.Time // latest time of the version 1, 6 and 7 UUIDs
+	Entropy       float64   // estimated entropy per random bit, from 0 to 1
+}
+
+// Spread returns the time between the oldest and the newest UUID with a
+// timestamp, or 0 if there are none.
+func (s Stats) Spread() time.Duration {
+	return s.Newest.Sub(s.Oldest)
+}
+
+// randomMask returns the mask of the bits of a UUID of version v filled by
+// the random source: all bits but the version and variant of a Version 4
+// UUID, and rand_b of a Version 7 UUID, as rand_a may hold a counter.
+func randomMask(v Version) (m UUID, ok bool) {
+	switch v {
+	case 4:
+		m = Max
+		m[6] = 0x0f
+	case 7:
+		copy(m[8:], Max[8:])
+	default:
+		return m, false
+	}
+	m[8] = 0x3f
+	return m, true
+}
+
+// analyzer accumulates the Stats of UUIDs.
+type analyzer struct {
+	stats Stats
+	seen  map[UUID]struct{}
+	ones  [128]int // number of times each random bit was set
+	n     [128]int // number of times each bit was random
+}
+
+func (a *analyzer) add(u UUID) {
+	s := &a.stats
+	s.Count++
+	if a.seen == nil {
+		a.seen = make(map[UUID]struct{})
+	}
+	if _, ok := a.seen[u]; ok {
+		s.Duplicates++
+	}
+	a.seen[u] = struct{}{}
+
+	if u.Variant() != RFC4122 {
+		s.OtherVariants++
+		return
+	}
+	v := u.Version()
+	s.Versions[v]++
+	if t, ok := u.timestamp(); ok {
+		if s.Oldest.IsZero() || t.Before(s.Oldest) {
+			s.Oldest = t
+		}
+		if s.Newest.IsZero() || t.After(s.Newest) {
+			s.Newest = t
+		}
+	}
+	m, ok := randomMask(v)
+	if !ok {
+		return
+	}
+	for i := 0; i < 128; i++ {
+		bit := byte(0x80) >> (i % 8)
+		if m[i/8]&bit != 0 {
+			a.n[i]++
+			if u[i/8]&bit != 0 {
+				a.ones[i]++
+			}
+		}
+	}
+}
+
+// finish returns the Stats of the UUIDs added to a.  The entropy is the mean
+// of the Shannon entropy of each random bit, estimated from the frequency of
+// its ones.  It detects stuck and biased bits, such as those of a counter or
+// of a degenerate random source, but not all weaknesses of a random source.
+func (a *analyzer) finish() Stats {
+	var sum float64
+	var bits int
+	for i, n := range a.n {
+		if n == 0 {
+			continue
+		}
+		bits++
+		p := float64(a.ones[i]) / float64(n)
+		if p > 0 && p < 1 {
+			sum -= p*math.Log2(p) + (1-p)*math.Log2(1-p)
+		}
+	}
+	if bits > 0 {
+		a.stats.Entropy = sum / float64(bits)
+	}
+	return a.stats
+}
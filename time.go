@@ -6,6 +6,8 @@ package uuid
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 )
@@ -39,6 +41,36 @@ func (t Time) UnixTime() (sec, nsec int64) {
 	return sec, nsec
 }
 
+// GregorianOffset is the number of 100s of nanoseconds between the Gregorian
+// epoch of 15 Oct 1582, used by the timestamps of version 1 and 6 UUIDs, and
+// the Unix epoch of 1 Jan 1970.
+const GregorianOffset = g1582ns100
+
+// GregorianToTime returns the time of t, a number of 100s of nanoseconds since
+// 15 Oct 1582 such as the 60 bit timestamp of a version 1 or 6 UUID.
+func GregorianToTime(t uint64) time.Time {
+	return time.Unix(Time(t).UnixTime())
+}
+
+// TimeToGregorian returns t as a number of 100s of nanoseconds since 15 Oct
+// 1582, truncating t to a multiple of 100 nanoseconds.  The result is
+// undefined for times before 15 Oct 1582.
+func TimeToGregorian(t time.Time) uint64 {
+	return uint64(t.Unix()*10000000+int64(t.Nanosecond()/100)) + g1582ns100
+}
+
+// UnixMilliToTime returns the time of ms, a number of milliseconds since 1 Jan
+// 1970 such as the 48 bit timestamp of a version 7 UUID.
+func UnixMilliToTime(ms int64) time.Time {
+	return time.Unix(ms/1000, ms%1000*nanoPerMilli)
+}
+
+// TimeToUnixMilli returns t as a number of milliseconds since 1 Jan 1970,
+// rounding t down to the millisecond, as in the timestamp of a version 7 UUID.
+func TimeToUnixMilli(t time.Time) int64 {
+	return t.Unix()*1000 + int64(t.Nanosecond())/nanoPerMilli
+}
+
 // GetTime returns the current Time (100s of nanoseconds since 15 Oct 1582) and
 // clock sequence as well as adjusting the clock sequence as needed.  An error
 // is returned if the current time cannot be determined.
@@ -51,7 +83,7 @@ func GetTime() (Time, uint16, error) {
 func getTime(customTime *time.Time) (Time, uint16, error) {
 	var t time.Time
 	if customTime == nil { // When not provided, use the current time
-		t = timeNow()
+		t = now()
 	} else {
 		t = *customTime
 	}
@@ -134,6 +166,79 @@ func (uuid UUID) Time() Time {
 	return t
 }
 
+// timestamp returns the time encoded in uuid and true for version 1, 6 and 7
+// UUIDs, and false for all other versions.  Version 2 UUIDs are excluded as
+// their low time bits are replaced by the local domain id.
+func (uuid UUID) timestamp() (time.Time, bool) {
+	switch uuid.Version() {
+	case 1, 6, 7:
+		return time.Unix(uuid.Time().UnixTime()), true
+	}
+	return time.Time{}, false
+}
+
+// Age returns the time elapsed since the time encoded in uuid, and true, if
+// uuid is a version 1, 6 or 7 UUID.  The age of a UUID with a time in the
+// future is negative.  Age returns 0 and false for all other versions.
+func (uuid UUID) Age() (time.Duration, bool) {
+	t, ok := uuid.timestamp()
+	if !ok {
+		return 0, false
+	}
+	return now().Sub(t), true
+}
+
+// CreatedAfter reports whether uuid is a version 1, 6 or 7 UUID with a time
+// after t.  CreatedAfter returns false for all other versions, so that, for
+// example, stale idempotency keys are rejected with
+//
+//	if !key.CreatedAfter(time.Now().Add(-24 * time.Hour)) {
+//		return errStaleKey
+//	}
+func (uuid UUID) CreatedAfter(t time.Time) bool {
+	ts, ok := uuid.timestamp()
+	return ok && ts.After(t)
+}
+
+// ErrNoTimestamp is returned by ValidateTimestamp for UUIDs of a version
+// without a timestamp.
+var ErrNoTimestamp = errors.New("UUID version has no timestamp")
+
+type timestampSkewError struct{ skew time.Duration }
+
+func (e timestampSkewError) Error() string {
+	if e.skew < 0 {
+		return fmt.Sprintf("UUID timestamp is %v in the past", -e.skew)
+	}
+	return fmt.Sprintf("UUID timestamp is %v in the future", e.skew)
+}
+
+func (e timestampSkewError) Is(target error) bool {
+	_, ok := target.(timestampSkewError)
+	return ok
+}
+
+// ErrTimestampSkew matches, using errors.Is, the errors returned by
+// ValidateTimestamp for UUIDs with a timestamp too far from the current time.
+var ErrTimestampSkew = timestampSkewError{}
+
+// ValidateTimestamp returns an error if the time encoded in uuid is more than
+// maxSkew before or after the current time, or ErrNoTimestamp if uuid is not
+// a version 1, 6 or 7 UUID.  ValidateTimestamp is meant for freshly generated
+// UUIDs received from untrusted clients, such as idempotency keys, to reject
+// forged keys crafted to land in hot ranges of time ordered indexes.
+func ValidateTimestamp(uuid UUID, maxSkew time.Duration) error {
+	t, ok := uuid.timestamp()
+	if !ok {
+		return ErrNoTimestamp
+	}
+	skew := t.Sub(now())
+	if skew > maxSkew || skew < -maxSkew {
+		return timestampSkewError{skew}
+	}
+	return nil
+}
+
 // ClockSequence returns the clock sequence encoded in uuid.
 // The clock sequence is only well defined for version 1 and 2 UUIDs.
 func (uuid UUID) ClockSequence() int {
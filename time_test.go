@@ -1,6 +1,8 @@
 package uuid
 
 import (
+	"encoding/binary"
+	"errors"
 	"testing"
 	"time"
 )
@@ -42,3 +44,119 @@ func TestGetTime(t *testing.T) {
 		})
 	}
 }
+
+func TestAge(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	past := now.Add(-time.Hour)
+	v6, _ := NewV6WithTime(&past)
+	g, _ := NewGenerator()
+	v7 := Must(g.NewV7())
+	v4 := New()
+	for _, tt := range []struct {
+		uuid UUID
+		age  time.Duration
+		ok   bool
+	}{
+		{Must(NewUUID()), 0, true},
+		{v6, time.Hour, true},
+		{v7, 0, true},
+		{v4, 0, false},
+		{NameSpaceDNS, 0, true}, // version 1
+	} {
+		age, ok := tt.uuid.Age()
+		if ok != tt.ok {
+			t.Errorf("%s: Age() ok = %v, want %v", tt.uuid, ok, tt.ok)
+		}
+		if tt.uuid != NameSpaceDNS && age != tt.age {
+			t.Errorf("%s: Age() = %v, want %v", tt.uuid, age, tt.age)
+		}
+	}
+
+	if !v6.CreatedAfter(past.Add(-time.Millisecond)) {
+		t.Errorf("CreatedAfter(before) = false, want true")
+	}
+	if v6.CreatedAfter(past) {
+		t.Errorf("CreatedAfter(same time) = true, want false")
+	}
+	if v4.CreatedAfter(time.Time{}) {
+		t.Errorf("CreatedAfter of a version 4 UUID = true, want false")
+	}
+}
+
+func TestValidateTimestamp(t *testing.T) {
+	now := time.Date(2024, 10, 15, 9, 32, 23, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	at := func(d time.Duration) UUID {
+		t := now.Add(d)
+		uuid, _ := NewV6WithTime(&t)
+		return uuid
+	}
+	for _, tt := range []struct {
+		uuid UUID
+		err  error
+	}{
+		{at(0), nil},
+		{at(time.Minute), nil},
+		{at(-time.Minute), nil},
+		{at(time.Hour), ErrTimestampSkew},
+		{at(-time.Hour), ErrTimestampSkew},
+		{Max, ErrNoTimestamp},
+		{New(), ErrNoTimestamp},
+	} {
+		err := ValidateTimestamp(tt.uuid, 5*time.Minute)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ValidateTimestamp(%s) got %v, want %v", tt.uuid, err, tt.err)
+		}
+	}
+	if err := ValidateTimestamp(at(time.Hour), time.Minute); err.Error() != "UUID timestamp is 1h0m0s in the future" {
+		t.Errorf("got error %q", err)
+	}
+	if err := ValidateTimestamp(at(-time.Hour), time.Minute); err.Error() != "UUID timestamp is 1h0m0s in the past" {
+		t.Errorf("got error %q", err)
+	}
+}
+
+func TestEpochHelpers(t *testing.T) {
+	if GregorianOffset != 122192928000000000 {
+		t.Errorf("GregorianOffset = %d", uint64(GregorianOffset))
+	}
+	if got := GregorianToTime(0); !got.Equal(time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("GregorianToTime(0) = %v", got.UTC())
+	}
+	if got := GregorianToTime(GregorianOffset); !got.Equal(time.Unix(0, 0)) {
+		t.Errorf("GregorianToTime(GregorianOffset) = %v", got.UTC())
+	}
+	for _, tt := range []time.Time{
+		time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 999999900, time.UTC),
+		time.Date(2024, 10, 15, 9, 32, 23, 123456700, time.UTC),
+		time.Date(4000, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if got := GregorianToTime(TimeToGregorian(tt)); !got.Equal(tt) {
+			t.Errorf("GregorianToTime(TimeToGregorian(%v)) = %v", tt, got.UTC())
+		}
+		ms := tt.Truncate(time.Millisecond)
+		if got := UnixMilliToTime(TimeToUnixMilli(tt)); !got.Equal(ms) {
+			t.Errorf("UnixMilliToTime(TimeToUnixMilli(%v)) = %v, want %v", tt, got.UTC(), ms)
+		}
+	}
+
+	uuid := MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f") // RFC 9562, Appendix A.6
+	ms := int64(binary.BigEndian.Uint64(uuid[:8]) >> 16)
+	if sec, nsec := uuid.Time().UnixTime(); !UnixMilliToTime(ms).Equal(time.Unix(sec, nsec)) {
+		t.Errorf("UnixMilliToTime(%d) = %v, want %v", ms, UnixMilliToTime(ms).UTC(), time.Unix(sec, nsec).UTC())
+	}
+	v6 := MustParse("1ec9414c-232a-6b00-b3c8-9f6bdeced846") // RFC 9562, Appendix A.5
+	if got, want := GregorianToTime(uint64(v6.Time())), time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("GregorianToTime(v6) = %v, want %v", got.UTC(), want)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"time"
+)
+
+// ErrLayoutTime is returned by TimeLayout.NewAt for times before the epoch or
+// at or after the rollover of the layout.
+var ErrLayoutTime = errors.New("time out of range for the time layout")
+
+// A TimeLayout is a time based Version 8 layout whose UUIDs start with a
+// timestamp counting a unit of time from a product specific epoch, in fewer
+// bits than the 48 bit Unix time in milliseconds of Version 7 UUIDs:
+//
+//	timestamp   Bits bits, (t - epoch) / unit
+//	free        48 - Bits bits
+//	ver          4 bits, 8
+//	free        12 bits
+//	var          2 bits, 0b10
+//	free        62 bits
+//
+// The free bits are random, and may be replaced with other fields using
+// SetBits, starting at offset Bits.  The UUIDs of a TimeLayout sort by time
+// until its Rollover, when the timestamp wraps around.
+//
+// The default layout, as returned by NewTimeLayout without options, counts
+// milliseconds since 1 Jan 1970 in 48 bits, as Version 7 UUIDs do.
+type TimeLayout struct {
+	epoch time.Time
+	unit  time.Duration
+	bits  int
+}
+
+// A TimeLayoutOption configures a TimeLayout created by NewTimeLayout.
+type TimeLayoutOption func(*TimeLayout)
+
+// WithCustomEpoch makes the timestamp of the TimeLayout count from t instead
+// of 1 Jan 1970, such as the launch date of a product, so that fewer bits
+// cover its lifetime.
+func WithCustomEpoch(t time.Time) TimeLayoutOption {
+	return func(l *TimeLayout) {
+		l.epoch = t
+	}
+}
+
+// WithTimeBits sets the width of the timestamp of the TimeLayout to n bits,
+// from 16 to 48.  The default is 48.
+func WithTimeBits(n int) TimeLayoutOption {
+	return func(l *TimeLayout) {
+		l.bits = n
+	}
+}
+
+// WithTimeUnit sets the unit of the timestamp of the TimeLayout to d, from a
+// microsecond to an hour.  The default is a millisecond.
+func WithTimeUnit(d time.Duration) TimeLayoutOption {
+	return func(l *TimeLayout) {
+		l.unit = d
+	}
+}
+
+// NewTimeLayout returns a TimeLayout configured by opts.  For example, 32
+// bits of seconds since 1 Jan 2024 roll over in 2160:
+//
+//	l, err := uuid.NewTimeLayout(
+//		uuid.WithCustomEpoch(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+//		uuid.WithTimeUnit(time.Second),
+//		uuid.WithTimeBits(32),
+//	)
+func NewTimeLayout(opts ...TimeLayoutOption) (*TimeLayout, error) {
+	l := &TimeLayout{epoch: time.Unix(0, 0), unit: time.Millisecond, bits: 48}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.bits < 16 || l.bits > 48 {
+		return nil, fmt.Errorf("invalid time layout bits: %d", l.bits)
+	}
+	if l.unit < time.Microsecond || l.unit > time.Hour {
+		return nil, fmt.Errorf("invalid time layout unit: %v", l.unit)
+	}
+	return l, nil
+}
+
+// Bits returns the width of the timestamp of l, and thus the offset of its
+// first free bit.
+func (l *TimeLayout) Bits() int {
+	return l.bits
+}
+
+// Rollover returns the first time that cannot be represented in l, when the
+// timestamp of l wraps around.
+func (l *TimeLayout) Rollover() time.Time {
+	return l.after(1 << uint(l.bits))
+}
+
+// New returns a UUID of l of the current time, with random free bits read
+// as NewRandom does.
+func (l *TimeLayout) New() (UUID, error) {
+	return l.NewAt(now())
+}
+
+// NewAt is like New but for time t.  ErrLayoutTime is returned if t is before
+// the epoch of l or not before its Rollover.
+func (l *TimeLayout) NewAt(t time.Time) (UUID, error) {
+	ts, ok := l.ticks(t)
+	if !ok {
+		return Nil, ErrLayoutTime
+	}
+	uuid, err := NewRandom()
+	if err != nil {
+		return Nil, err
+	}
+	uuid, _ = SetBits(uuid, 0, l.bits, ts)
+	uuid[6] = uuid[6]&0x0f | 0x80 // Version 8
+	return uuid, nil
+}
+
+// ticks returns the timestamp of t in l, and false if it does not fit.
+func (l *TimeLayout) ticks(t time.Time) (uint64, bool) {
+	if t.Before(l.epoch) {
+		return 0, false
+	}
+	sec := uint64(t.Unix() - l.epoch.Unix())
+	nsec := int64(t.Nanosecond()) - int64(l.epoch.Nanosecond())
+	if nsec < 0 {
+		sec--
+		nsec += int64(time.Second)
+	}
+	hi, lo := bits.Mul64(sec, uint64(time.Second))
+	lo, carry := bits.Add64(lo, uint64(nsec), 0)
+	hi += carry
+	if hi >= uint64(l.unit) {
+		return 0, false
+	}
+	ts, _ := bits.Div64(hi, lo, uint64(l.unit))
+	return ts, ts < 1<<uint(l.bits)
+}
+
+// Time returns the time of uuid, a UUID of l, truncated to the unit of l.
+func (l *TimeLayout) Time(uuid UUID) time.Time {
+	return l.after(GetBits(uuid, 0, l.bits))
+}
+
+// after returns the time ts units after the epoch of l.  As ts units may
+// overflow a time.Duration, the time is computed in seconds.
+func (l *TimeLayout) after(ts uint64) time.Time {
+	hi, lo := bits.Mul64(uint64(l.unit), ts)
+	sec, nsec := bits.Div64(hi, lo, uint64(time.Second))
+	return time.Unix(l.epoch.Unix()+int64(sec), int64(l.epoch.Nanosecond())+int64(nsec)).In(l.epoch.Location())
+}
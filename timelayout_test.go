@@ -0,0 +1,72 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeLayout(t *testing.T) {
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l, err := NewTimeLayout(WithCustomEpoch(epoch), WithTimeUnit(time.Second), WithTimeBits(32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := epoch.Add(time.Duration(1<<32) * time.Second); !l.Rollover().Equal(want) {
+		t.Errorf("Rollover() = %v, want %v", l.Rollover(), want)
+	}
+	at := time.Date(2026, 10, 15, 7, 30, 15, 999999999, time.UTC)
+	u, err := l.NewAt(at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Version() != 8 || u.Variant() != RFC4122 {
+		t.Errorf("NewAt returned %s of version %s and variant %s", u, u.Version(), u.Variant())
+	}
+	if got, want := l.Time(u), at.Truncate(time.Second); !got.Equal(want) {
+		t.Errorf("Time() = %v, want %v", got, want)
+	}
+	if ts := GetBits(u, 0, 32); ts != uint64(at.Sub(epoch)/time.Second) {
+		t.Errorf("timestamp = %d, want %d", ts, at.Sub(epoch)/time.Second)
+	}
+	later, _ := l.NewAt(at.Add(time.Second))
+	if Compare(u, later) >= 0 {
+		t.Errorf("UUID of a later time %s not after %s", later, u)
+	}
+	for _, bad := range []time.Time{epoch.Add(-time.Nanosecond), l.Rollover(), l.Rollover().Add(100 * 365 * 24 * time.Hour)} {
+		if _, err := l.NewAt(bad); err != ErrLayoutTime {
+			t.Errorf("NewAt(%v) returned %v, want %v", bad, err, ErrLayoutTime)
+		}
+	}
+	if _, err := l.NewAt(l.Rollover().Add(-time.Nanosecond)); err != nil {
+		t.Errorf("NewAt before Rollover: %v", err)
+	}
+
+	// The default layout is that of Version 7 UUIDs.
+	d, _ := NewTimeLayout()
+	v8 := Must(d.NewAt(at))
+	if want, _ := V7Bounds(at, at); GetBits(v8, 0, 48) != GetBits(want, 0, 48) {
+		t.Errorf("default layout timestamp %x, want %x", GetBits(v8, 0, 48), GetBits(want, 0, 48))
+	}
+	if want := UnixMilliToTime(1 << 48); !d.Rollover().Equal(want) {
+		t.Errorf("default Rollover() = %v, want %v", d.Rollover(), want)
+	}
+	h, _ := NewTimeLayout(WithTimeUnit(time.Hour))
+	if y := h.Rollover().Year(); y < 1e10 {
+		t.Errorf("Rollover() of 48 bits of hours in year %d", y)
+	}
+
+	for _, opts := range [][]TimeLayoutOption{
+		{WithTimeBits(15)},
+		{WithTimeBits(49)},
+		{WithTimeUnit(time.Nanosecond)},
+		{WithTimeUnit(2 * time.Hour)},
+	} {
+		if _, err := NewTimeLayout(opts...); err == nil {
+			t.Errorf("NewTimeLayout with invalid options succeeded")
+		}
+	}
+}
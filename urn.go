@@ -0,0 +1,54 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"net/url"
+	"strings"
+)
+
+// urnPrefix is the prefix of the URN namespace of UUIDs (RFC 9562 section 4).
+const urnPrefix = "urn:uuid:"
+
+// ParseURN parses s as a URN of the uuid namespace,
+// urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, rejecting all other forms
+// accepted by Parse.  As RFC 8141 specifies, the "urn" scheme and the "uuid"
+// namespace are case-insensitive.  URNs with components, such as a trailing
+// "?=" query or "#" fragment, are not UUIDs and are rejected.
+func ParseURN(s string) (UUID, error) {
+	if len(s) < len(urnPrefix) || !strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		prefix := s
+		if len(prefix) > len(urnPrefix) {
+			prefix = prefix[:len(urnPrefix)]
+		}
+		return Nil, URNPrefixError{prefix}
+	}
+	if len(s) != 36+len(urnPrefix) {
+		return Nil, invalidLengthError{len(s)}
+	}
+	return Parse(s)
+}
+
+// URNValue returns the URN of uuid as a url.URL, for APIs such as SAML and
+// DataCite metadata that expect URIs.  The String method of the URL returns
+// the same string as URN, in upper case if SetUppercase is used.
+func (uuid UUID) URNValue() url.URL {
+	var buf [36 + 5]byte
+	copy(buf[:], "uuid:")
+	encodeHex(buf[5:], uuid)
+	if loadConfig().upper {
+		upperHex(buf[5:])
+	}
+	return url.URL{Scheme: "urn", Opaque: string(buf[:])}
+}
+
+// FromURNValue returns the UUID of u, a URL of the "urn" scheme as returned by
+// URNValue or url.Parse, with the same rules as ParseURN.
+func FromURNValue(u *url.URL) (UUID, error) {
+	if u.Host != "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "" || u.ForceQuery {
+		return Nil, ErrInvalidUUIDFormat
+	}
+	return ParseURN(u.Scheme + ":" + u.Opaque)
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestParseURN(t *testing.T) {
+	s := NameSpaceDNS.String()
+	for _, tt := range []struct {
+		in  string
+		err error
+	}{
+		{"urn:uuid:" + s, nil},
+		{"URN:UUID:" + s, nil},
+		{"urn:uuid:6BA7B810-9DAD-11D1-80B4-00C04FD430C8", nil},
+		{s, ErrInvalidURNPrefix},
+		{"{" + s + "}", ErrInvalidURNPrefix},
+		{"urn:oid:" + s, ErrInvalidURNPrefix},
+		{"urn:uu", ErrInvalidURNPrefix},
+		{"urn:uuid:" + s + "#frag", ErrInvalidLength},
+		{"urn:uuid:6ba7b8109dad11d180b400c04fd430c8", ErrInvalidLength},
+		{"urn:uuid:" + s[:35] + "x", ErrInvalidUUIDFormat},
+	} {
+		got, err := ParseURN(tt.in)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("ParseURN(%q) got error %v, want %v", tt.in, err, tt.err)
+		}
+		if err == nil && got != NameSpaceDNS {
+			t.Errorf("ParseURN(%q) = %s, want %s", tt.in, got, NameSpaceDNS)
+		}
+	}
+}
+
+func TestURNValue(t *testing.T) {
+	u := NameSpaceDNS.URNValue()
+	if s := u.String(); s != NameSpaceDNS.URN() {
+		t.Errorf("URNValue().String() = %q, want %q", s, NameSpaceDNS.URN())
+	}
+	if got, err := FromURNValue(&u); err != nil || got != NameSpaceDNS {
+		t.Errorf("FromURNValue = %s, %v, want %s", got, err, NameSpaceDNS)
+	}
+	for _, s := range []string{
+		NameSpaceDNS.URN(),
+		"URN:uuid:" + NameSpaceDNS.String(),
+	} {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := FromURNValue(u); err != nil || got != NameSpaceDNS {
+			t.Errorf("FromURNValue(%q) = %s, %v, want %s", s, got, err, NameSpaceDNS)
+		}
+	}
+	for _, s := range []string{
+		NameSpaceDNS.URN() + "?=q",
+		NameSpaceDNS.URN() + "#f",
+		"https://example.com/" + NameSpaceDNS.String(),
+	} {
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := FromURNValue(u); err == nil {
+			t.Errorf("FromURNValue(%q) succeeded", s)
+		}
+	}
+}
@@ -6,12 +6,14 @@ package uuid
 
 import (
 	"bytes"
+	"crypto/subtle"
 	"io"
+	"strings"
 )
 
 // randomBits completely fills slice b with random data.
 func randomBits(b []byte) {
-	if _, err := io.ReadFull(rander, b); err != nil {
+	if _, err := io.ReadFull(loadConfig().rand, b); err != nil {
 		panic(err.Error()) // rand should never fail
 	}
 }
@@ -47,3 +49,54 @@ func xtob(x1, x2 byte) (byte, bool) {
 func Compare(a, b UUID) int {
 	return bytes.Compare(a[:], b[:])
 }
+
+// EqualPtr reports whether a and b are both nil or both point to equal UUIDs,
+// for optional IDs such as nullable foreign keys.  A nil pointer is not equal
+// to a pointer to Nil.
+func EqualPtr(a, b *UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Coalesce returns the first of ids that is not Nil, or Nil if there is none.
+func Coalesce(ids ...UUID) UUID {
+	for _, id := range ids {
+		if id != Nil {
+			return id
+		}
+	}
+	return Nil
+}
+
+// EqualString reports whether s is a string form of u accepted by ParseFormat
+// with FormatAny: the standard form, with or without hyphens, braces,
+// parentheses or the urn:uuid: prefix, in upper or lower case.  EqualString
+// does not allocate and, unlike comparing the result of Parse with u, takes
+// the same time for all UUIDs of the same form, so that it can compare an ID
+// received in a request header with a stored secret ID without a timing side
+// channel.
+func EqualString(u UUID, s string) bool {
+	switch len(s) {
+	case 36 + 9:
+		if !strings.EqualFold(s[:9], "urn:uuid:") {
+			return false
+		}
+		s = s[9:]
+	case 36 + 2:
+		if !(s[0] == '{' && s[37] == '}' || s[0] == '(' && s[37] == ')') {
+			return false
+		}
+		s = s[1:37]
+	}
+	var v UUID
+	var ok bool
+	switch len(s) {
+	case 36:
+		v, ok = decodeCanonical(s)
+	case 32:
+		v, ok = decodeHex(s)
+	}
+	return ok && subtle.ConstantTimeCompare(u[:], v[:]) == 1
+}
@@ -12,7 +12,6 @@ import (
 	"fmt"
 	"io"
 	"strings"
-	"sync"
 )
 
 // A UUID is a 128 bit (16 byte) Universal Unique IDentifier as defined in RFC
@@ -42,16 +41,19 @@ const Standard = RFC4122
 const randPoolSize = 16 * 16
 
 var (
-	rander      = rand.Reader // random function
-	poolEnabled = false
-	poolMu      sync.Mutex
-	poolPos     = randPoolSize     // protected with poolMu
-	pool        [randPoolSize]byte // protected with poolMu
+	pool = NewBufferedReader(globalRand{}, randPoolSize)
 
 	ErrInvalidUUIDFormat      = errors.New("invalid UUID format")
 	ErrInvalidBracketedFormat = errors.New("invalid bracketed UUID format")
 )
 
+// MaxStringLen is the length of the longest string accepted by Parse, the URN
+// form urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.  Parse rejects all
+// strings by their length alone unless they are 32, 36, 38 or 45 bytes long,
+// so that callers reading untrusted input may discard longer input before
+// parsing it.
+const MaxStringLen = 36 + 9
+
 type URNPrefixError struct { prefix string }
 
 func (e URNPrefixError) Error() string {
@@ -92,54 +94,38 @@ func IsInvalidLengthError(err error) bool {
 // e.g.  {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}.  Only the middle 36 bytes are
 // examined in the latter case.  Parse should not be used to validate strings as
 // it parses non-standard encodings as indicated above.
+//
+// Parse does not allocate and is faster than a lookup of s in a concurrency
+// safe LRU cache, so caching the UUIDs of frequently parsed strings, even a
+// few thousand hot tenant IDs, does not pay off.
 func Parse(s string) (UUID, error) {
 	var uuid UUID
+	var ok bool
 	switch len(s) {
 	// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 	case 36:
+		uuid, ok = decodeCanonical(s)
 
 	// urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 	case 36 + 9:
 		if !strings.EqualFold(s[:9], "urn:uuid:") {
 			return uuid, URNPrefixError{s[:9]}
 		}
-		s = s[9:]
+		uuid, ok = decodeCanonical(s[9:])
 
 	// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
 	case 36 + 2:
-		s = s[1:]
+		uuid, ok = decodeCanonical(s[1:37])
 
 	// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
 	case 32:
-		var ok bool
-		for i := range uuid {
-			uuid[i], ok = xtob(s[i*2], s[i*2+1])
-			if !ok {
-				return uuid, ErrInvalidUUIDFormat
-			}
-		}
-		return uuid, nil
+		uuid, ok = decodeHex(s)
+
 	default:
 		return uuid, invalidLengthError{len(s)}
 	}
-	// s is now at least 36 bytes long
-	// it must be of the form  xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-	if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
-		return uuid, ErrInvalidUUIDFormat
-
-	}
-	for i, x := range [16]int{
-		0, 2, 4, 6,
-		9, 11,
-		14, 16,
-		19, 21,
-		24, 26, 28, 30, 32, 34,
-	} {
-		v, ok := xtob(s[x], s[x+1])
-		if !ok {
-			return uuid, ErrInvalidUUIDFormat
-		}
-		uuid[i] = v
+	if !ok {
+		return Nil, ErrInvalidUUIDFormat
 	}
 	return uuid, nil
 }
@@ -147,48 +133,95 @@ func Parse(s string) (UUID, error) {
 // ParseBytes is like Parse, except it parses a byte slice instead of a string.
 func ParseBytes(b []byte) (UUID, error) {
 	var uuid UUID
+	var ok bool
 	switch len(b) {
 	case 36: // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
+		uuid, ok = decodeCanonicalBytes(b)
 	case 36 + 9: // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
 		if !bytes.EqualFold(b[:9], []byte("urn:uuid:")) {
 			return uuid, URNPrefixError{string(b[:9])}
 		}
-		b = b[9:]
+		uuid, ok = decodeCanonicalBytes(b[9:])
 	case 36 + 2: // {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}
-		b = b[1:]
+		uuid, ok = decodeCanonicalBytes(b[1:37])
 	case 32: // xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
-		var ok bool
-		for i := 0; i < 32; i += 2 {
-			uuid[i/2], ok = xtob(b[i], b[i+1])
-			if !ok {
-				return uuid, ErrInvalidUUIDFormat
-			}
-		}
-		return uuid, nil
+		uuid, ok = decodeHex(string(b))
 	default:
 		return uuid, invalidLengthError{len(b)}
 	}
-	// s is now at least 36 bytes long
-	// it must be of the form  xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-	if b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
-		return uuid, ErrInvalidUUIDFormat
-	}
-	for i, x := range [16]int{
-		0, 2, 4, 6,
-		9, 11,
-		14, 16,
-		19, 21,
-		24, 26, 28, 30, 32, 34,
-	} {
-		v, ok := xtob(b[x], b[x+1])
-		if !ok {
-			return uuid, ErrInvalidUUIDFormat
-		}
-		uuid[i] = v
+	if !ok {
+		return Nil, ErrInvalidUUIDFormat
 	}
 	return uuid, nil
 }
 
+// decodeCanonical decodes s, which must be 36 bytes long, in the form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.  Rather than branching on each digit,
+// it accumulates the values of all digits, which are 255 for invalid ones,
+// and checks them once.
+func decodeCanonical(s string) (uuid UUID, ok bool) {
+	_ = s[35] // bounds check
+	var bad byte
+	uuid[0], bad = decodeDigits(s[0], s[1], bad)
+	uuid[1], bad = decodeDigits(s[2], s[3], bad)
+	uuid[2], bad = decodeDigits(s[4], s[5], bad)
+	uuid[3], bad = decodeDigits(s[6], s[7], bad)
+	uuid[4], bad = decodeDigits(s[9], s[10], bad)
+	uuid[5], bad = decodeDigits(s[11], s[12], bad)
+	uuid[6], bad = decodeDigits(s[14], s[15], bad)
+	uuid[7], bad = decodeDigits(s[16], s[17], bad)
+	uuid[8], bad = decodeDigits(s[19], s[20], bad)
+	uuid[9], bad = decodeDigits(s[21], s[22], bad)
+	uuid[10], bad = decodeDigits(s[24], s[25], bad)
+	uuid[11], bad = decodeDigits(s[26], s[27], bad)
+	uuid[12], bad = decodeDigits(s[28], s[29], bad)
+	uuid[13], bad = decodeDigits(s[30], s[31], bad)
+	uuid[14], bad = decodeDigits(s[32], s[33], bad)
+	uuid[15], bad = decodeDigits(s[34], s[35], bad)
+	return uuid, bad < 16 && s[8] == '-' && s[13] == '-' && s[18] == '-' && s[23] == '-'
+}
+
+// decodeCanonicalBytes is like decodeCanonical but decodes a byte slice.
+func decodeCanonicalBytes(b []byte) (uuid UUID, ok bool) {
+	_ = b[35] // bounds check
+	var bad byte
+	uuid[0], bad = decodeDigits(b[0], b[1], bad)
+	uuid[1], bad = decodeDigits(b[2], b[3], bad)
+	uuid[2], bad = decodeDigits(b[4], b[5], bad)
+	uuid[3], bad = decodeDigits(b[6], b[7], bad)
+	uuid[4], bad = decodeDigits(b[9], b[10], bad)
+	uuid[5], bad = decodeDigits(b[11], b[12], bad)
+	uuid[6], bad = decodeDigits(b[14], b[15], bad)
+	uuid[7], bad = decodeDigits(b[16], b[17], bad)
+	uuid[8], bad = decodeDigits(b[19], b[20], bad)
+	uuid[9], bad = decodeDigits(b[21], b[22], bad)
+	uuid[10], bad = decodeDigits(b[24], b[25], bad)
+	uuid[11], bad = decodeDigits(b[26], b[27], bad)
+	uuid[12], bad = decodeDigits(b[28], b[29], bad)
+	uuid[13], bad = decodeDigits(b[30], b[31], bad)
+	uuid[14], bad = decodeDigits(b[32], b[33], bad)
+	uuid[15], bad = decodeDigits(b[34], b[35], bad)
+	return uuid, bad < 16 && b[8] == '-' && b[13] == '-' && b[18] == '-' && b[23] == '-'
+}
+
+// decodeDigits returns the byte of the hex digits x1 and x2, and bad ORed with
+// their values, which is 16 or more if one of them is not a hex digit.
+func decodeDigits(x1, x2, bad byte) (byte, byte) {
+	hi, lo := xvalues[x1], xvalues[x2]
+	return hi<<4 | lo, bad | hi | lo
+}
+
+// decodeHex decodes s, which must be 32 bytes long, in the form
+// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx, as decodeCanonical does.
+func decodeHex(s string) (uuid UUID, ok bool) {
+	_ = s[31] // bounds check
+	var bad byte
+	for i := range uuid {
+		uuid[i], bad = decodeDigits(s[2*i], s[2*i+1], bad)
+	}
+	return uuid, bad < 16
+}
+
 // MustParse is like Parse but panics if the string cannot be parsed.
 // It simplifies safe initialization of global variables holding compiled UUIDs.
 func MustParse(s string) UUID {
@@ -268,19 +301,27 @@ func Validate(s string) error {
 }
 
 // String returns the string form of uuid, xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx
-// , or "" if uuid is invalid.
+// , or "" if uuid is invalid.  The hex digits are in lower case unless
+// SetUppercase is used.
 func (uuid UUID) String() string {
 	var buf [36]byte
 	encodeHex(buf[:], uuid)
+	if loadConfig().upper {
+		upperHex(buf[:])
+	}
 	return string(buf[:])
 }
 
 // URN returns the RFC 2141 URN form of uuid,
 // urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx,  or "" if uuid is invalid.
+// The hex digits are in lower case unless SetUppercase is used.
 func (uuid UUID) URN() string {
 	var buf [36 + 9]byte
 	copy(buf[:], "urn:uuid:")
 	encodeHex(buf[9:], uuid)
+	if loadConfig().upper {
+		upperHex(buf[9:])
+	}
 	return string(buf[:])
 }
 
@@ -344,12 +385,14 @@ func (v Variant) String() string {
 //
 // Calling SetRand with nil sets the random number generator to the default
 // generator.
+//
+// SetRand may be called concurrently with the generation of UUIDs, which use
+// either the previous or the new generator.
 func SetRand(r io.Reader) {
 	if r == nil {
-		rander = rand.Reader
-		return
+		r = rand.Reader
 	}
-	rander = r
+	updateConfig(func(c *config) { c.rand = r })
 }
 
 // EnableRandPool enables internal randomness pool used for Random
@@ -358,26 +401,29 @@ func SetRand(r io.Reader) {
 // may improve the UUID generation throughput significantly.
 //
 // Since the pool is stored on the Go heap, this feature may be a bad fit
-// for security sensitive applications.
+// for security sensitive applications.  See also SetRandPoolWipe.
+//
+// The pool holds randPoolSize (256) bytes.  Use a Generator with
+// WithBufferSize to choose a different size.
 //
-// Both EnableRandPool and DisableRandPool are not thread-safe and should
-// only be called when there is no possibility that New or any other
-// UUID Version 4 generation function will be called concurrently.
+// EnableRandPool and DisableRandPool may be called concurrently with the
+// generation of UUIDs.
 func EnableRandPool() {
-	poolEnabled = true
+	updateConfig(func(c *config) { c.poolEnabled = true })
 }
 
 // DisableRandPool disables the randomness pool if it was previously
 // enabled with EnableRandPool.
-//
-// Both EnableRandPool and DisableRandPool are not thread-safe and should
-// only be called when there is no possibility that New or any other
-// UUID Version 4 generation function will be called concurrently.
 func DisableRandPool() {
-	poolEnabled = false
-	defer poolMu.Unlock()
-	poolMu.Lock()
-	poolPos = randPoolSize
+	updateConfig(func(c *config) { c.poolEnabled = false })
+	pool.Reset()
+}
+
+// globalRand reads from the random number generator set with SetRand.
+type globalRand struct{}
+
+func (globalRand) Read(p []byte) (int, error) {
+	return loadConfig().rand.Read(p)
 }
 
 // UUIDs is a slice of UUID types.
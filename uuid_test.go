@@ -579,7 +579,16 @@ func FuzzParse(f *testing.F) {
 		f.Add(strings.ToUpper(tt.in))
 	}
 	f.Fuzz(func(t *testing.T, in string) {
-		Parse(in)
+		u, err := Parse(in)
+		if err != nil {
+			return
+		}
+		if len(in) > MaxStringLen {
+			t.Fatalf("Parse accepted %q, longer than MaxStringLen", in)
+		}
+		if again, err := Parse(u.String()); again != u || err != nil {
+			t.Fatalf("Parse(%q) = %s, %v, want %s", u.String(), again, err, u)
+		}
 	})
 }
 
@@ -928,3 +937,158 @@ func TestVersion7MonotonicityStrict(t *testing.T) {
 		u1 = u2
 	}
 }
+
+func TestV7Bounds(t *testing.T) {
+	at := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+	min, max := V7Bounds(at, at)
+	if min.Version() != 7 || max.Version() != 7 || min.Variant() != RFC4122 || max.Variant() != RFC4122 {
+		t.Errorf("V7Bounds returned %s, %s", min, max)
+	}
+	for _, u := range []UUID{min, max} {
+		if sec, nsec := u.Time().UnixTime(); !time.Unix(sec, nsec).Equal(at) {
+			t.Errorf("%s has time %v, want %v", u, time.Unix(sec, nsec).UTC(), at)
+		}
+	}
+}
+
+func TestParseInvalidDigit(t *testing.T) {
+	for _, form := range []string{testUUID.String(), testUUID.URN(), "{" + testUUID.String() + "}", strings.Replace(testUUID.String(), "-", "", -1)} {
+		for i := 0; i < len(form); i++ {
+			if form[i] == '-' || form[i] == '{' || form[i] == '}' || i < 9 && form[0] == 'u' {
+				continue
+			}
+			for _, c := range []byte{'g', 'G', '-', ' ', 0, 0xff} {
+				s := form[:i] + string([]byte{c}) + form[i+1:]
+				if u, err := Parse(s); err != ErrInvalidUUIDFormat || u != Nil {
+					t.Errorf("Parse(%q) = %s, %v", s, u, err)
+				}
+				if u, err := ParseBytes([]byte(s)); err != ErrInvalidUUIDFormat || u != Nil {
+					t.Errorf("ParseBytes(%q) = %s, %v", s, u, err)
+				}
+			}
+		}
+	}
+}
+
+func TestParsePathological(t *testing.T) {
+	const c = "f47ac10b-58cc-0372-8567-0e02b2c3d479"
+	for _, s := range []string{
+		"f47ac10b-58cc-0372-8567-0e02b2c3d47\x00",
+		"\x00" + c[1:],
+		"f47ac10b\x0058cc-0372-8567-0e02b2c3d479",
+		"urn:uuid:" + c + "0",
+		"urn:uuid:urn:uuid:" + c,
+		"urn:uuid:" + c[:35],
+		"urn:uuid\x00" + c,
+		"f47ac10b\u201058cc-0372-8567-0e02b2c3d479",       // hyphen
+		"f47ac10b\u201358cc\u20130372-8567-0e02b2c3d479", // en dash
+		"f47ac10b\u221258cc-0372-8567-0e02b2c3d479",       // minus sign
+		"f47ac10b-58cc-0372-8567-0e02b2c3d4\uff17\uff19",  // fullwidth digits
+		"\ufeff" + c,
+		" " + c,
+		c + "\n",
+		"+f47ac10-58cc-0372-8567-0e02b2c3d479",
+		"f47ac10b-58cc-0372-8567+0e02b2c3d479",
+		strings.Repeat("f", 1<<20),
+		strings.Repeat(c, 1<<10),
+	} {
+		if u, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) = %s", s, u)
+		}
+		if u, err := ParseBytes([]byte(s)); err == nil {
+			t.Errorf("ParseBytes(%q) = %s", s, u)
+		}
+		if err := Validate(s); err == nil {
+			t.Errorf("Validate(%q) succeeded", s)
+		}
+	}
+
+	// Only the lengths of the forms of Parse are considered.
+	for n := 0; n <= 2*MaxStringLen; n++ {
+		s := strings.Repeat("0", n)
+		_, err := Parse(s)
+		switch n {
+		case 32:
+			if err != nil {
+				t.Errorf("Parse of %d zeros: %v", n, err)
+			}
+		case 36, 38:
+			if err != ErrInvalidUUIDFormat {
+				t.Errorf("Parse of %d zeros: got error %v, want %v", n, err, ErrInvalidUUIDFormat)
+			}
+		case MaxStringLen:
+			if !errors.Is(err, ErrInvalidURNPrefix) {
+				t.Errorf("Parse of %d zeros: got error %v, want %v", n, err, ErrInvalidURNPrefix)
+			}
+		default:
+			if !IsInvalidLengthError(err) {
+				t.Errorf("Parse of %d zeros: got error %v, want an invalid length error", n, err)
+			}
+		}
+	}
+}
+
+func TestEqualPtr(t *testing.T) {
+	a, b, nilUUID := testUUID, testUUID, Nil
+	other := NameSpaceDNS
+	for _, tt := range []struct {
+		a, b *UUID
+		want bool
+	}{
+		{nil, nil, true},
+		{&a, &b, true},
+		{&a, &a, true},
+		{&a, &other, false},
+		{&a, nil, false},
+		{nil, &nilUUID, false},
+	} {
+		if got := EqualPtr(tt.a, tt.b); got != tt.want {
+			t.Errorf("EqualPtr(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	if got := Coalesce(); got != Nil {
+		t.Errorf("Coalesce() = %s, want %s", got, Nil)
+	}
+	if got := Coalesce(Nil, Nil); got != Nil {
+		t.Errorf("Coalesce(Nil, Nil) = %s, want %s", got, Nil)
+	}
+	if got := Coalesce(Nil, testUUID, NameSpaceDNS); got != testUUID {
+		t.Errorf("Coalesce(Nil, %s, %s) = %s, want %s", testUUID, NameSpaceDNS, got, testUUID)
+	}
+}
+
+func TestEqualString(t *testing.T) {
+	s := testUUID.String()
+	for _, ok := range []string{
+		s,
+		strings.ToUpper(s),
+		strings.Replace(s, "-", "", -1),
+		"{" + s + "}",
+		"(" + strings.ToUpper(s) + ")",
+		"URN:UUID:" + s,
+	} {
+		if !EqualString(testUUID, ok) {
+			t.Errorf("EqualString(%s, %q) = false, want true", testUUID, ok)
+		}
+	}
+	for _, bad := range []string{
+		"",
+		NameSpaceDNS.String(),
+		s[:35],
+		s[:35] + "0",
+		s[:8] + "0" + s[9:],
+		"{" + s + ")",
+		"urn:guid:" + s,
+		strings.Replace(s, "-", "", -1)[:31] + "g",
+	} {
+		if EqualString(testUUID, bad) {
+			t.Errorf("EqualString(%s, %q) = true, want false", testUUID, bad)
+		}
+	}
+	if n := testing.AllocsPerRun(100, func() { EqualString(testUUID, s) }); n != 0 {
+		t.Errorf("EqualString allocates %v times", n)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidgrpc provides gRPC interceptors that carry a correlation ID
+// from request to request.  The server interceptors read the ID from the
+// incoming metadata, or mint a new Version 7 UUID if there is none, and store
+// it in the context of the handler.  The client interceptors add the ID of
+// the context to the outgoing metadata, so it follows a request through all
+// services:
+//
+//	srv := grpc.NewServer(
+//		grpc.ChainUnaryInterceptor(uuidgrpc.UnaryServerInterceptor()),
+//		grpc.ChainStreamInterceptor(uuidgrpc.StreamServerInterceptor()),
+//	)
+//	conn, err := grpc.NewClient(target,
+//		grpc.WithChainUnaryInterceptor(uuidgrpc.UnaryClientInterceptor()),
+//		grpc.WithChainStreamInterceptor(uuidgrpc.StreamClientInterceptor()),
+//	)
+//
+// The package is a separate module so that the uuid package itself does not
+// depend on gRPC.
+package uuidgrpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the metadata key of the correlation ID.
+const MetadataKey = "x-request-id"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the correlation ID id.
+func NewContext(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID of ctx, if any.
+func FromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(contextKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// incoming returns ctx carrying the correlation ID of its incoming metadata,
+// or a new Version 7 UUID if the metadata has no valid ID, and the ID.
+func incoming(ctx context.Context) (context.Context, uuid.UUID, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, s := range md.Get(MetadataKey) {
+			if id, err := uuid.Parse(s); err == nil {
+				return NewContext(ctx, id), id, nil
+			}
+		}
+	}
+	id, err := uuid.NewV7()
+	if err != nil {
+		return ctx, id, err
+	}
+	return NewContext(ctx, id), id, nil
+}
+
+// outgoing returns ctx with the correlation ID of ctx added to its outgoing
+// metadata, or ctx if it has no ID.
+func outgoing(ctx context.Context) context.Context {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, id.String())
+}
+
+// UnaryServerInterceptor returns a server interceptor that stores the
+// correlation ID of each request in the context of its handler.  The ID is
+// also sent to the client in the response header.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, id, err := incoming(ctx)
+		if err != nil {
+			return nil, err
+		}
+		grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, id.String()))
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is like UnaryServerInterceptor for streams.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id, err := incoming(ss.Context())
+		if err != nil {
+			return err
+		}
+		ss.SetHeader(metadata.Pairs(MetadataKey, id.String()))
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientInterceptor returns a client interceptor that adds the
+// correlation ID of the context of each call to its metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoing(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is like UnaryClientInterceptor for streams.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoing(ctx), desc, cc, method, opts...)
+	}
+}
+
+// serverStream is a grpc.ServerStream with the context of the correlation ID.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	want := uuid.MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	for _, tt := range []struct {
+		md   metadata.MD
+		mint bool
+	}{
+		{metadata.Pairs(MetadataKey, want.String()), false},
+		{metadata.Pairs(MetadataKey, "bogus", MetadataKey, want.String()), false},
+		{metadata.Pairs(MetadataKey, "bogus"), true},
+		{nil, true},
+	} {
+		ctx := context.Background()
+		if tt.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, tt.md)
+		}
+		var got uuid.UUID
+		var ok bool
+		_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			got, ok = FromContext(ctx)
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch {
+		case !ok:
+			t.Errorf("%v: handler context has no ID", tt.md)
+		case tt.mint && got.Version() != 7:
+			t.Errorf("%v: minted %s, want a Version 7 UUID", tt.md, got)
+		case !tt.mint && got != want:
+			t.Errorf("%v: got %s, want %s", tt.md, got, want)
+		}
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	md  metadata.MD
+}
+
+func (s *testServerStream) Context() context.Context       { return s.ctx }
+func (s *testServerStream) SetHeader(md metadata.MD) error { s.md = md; return nil }
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ss := &testServerStream{ctx: context.Background()}
+	var got uuid.UUID
+	err := StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{}, func(_ interface{}, stream grpc.ServerStream) error {
+		got, _ = FromContext(stream.Context())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version() != 7 {
+		t.Errorf("minted %s, want a Version 7 UUID", got)
+	}
+	if h := ss.md.Get(MetadataKey); len(h) != 1 || h[0] != got.String() {
+		t.Errorf("response header %v, want %s", h, got)
+	}
+}
+
+func TestClientInterceptors(t *testing.T) {
+	id := uuid.MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	check := func(name string, ctx context.Context, want []string) {
+		t.Helper()
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if got := md.Get(MetadataKey); len(got) != len(want) || len(want) == 1 && got[0] != want[0] {
+			t.Errorf("%s: outgoing metadata %v, want %v", name, got, want)
+		}
+	}
+	for _, tt := range []struct {
+		ctx  context.Context
+		want []string
+	}{
+		{NewContext(context.Background(), id), []string{id.String()}},
+		{context.Background(), nil},
+	} {
+		UnaryClientInterceptor()(tt.ctx, "/m", nil, nil, nil, func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			check("unary", ctx, tt.want)
+			return nil
+		})
+		StreamClientInterceptor()(tt.ctx, &grpc.StreamDesc{}, nil, "/m", func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+			check("stream", ctx, tt.want)
+			return nil, nil
+		})
+	}
+}
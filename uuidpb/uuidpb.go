@@ -0,0 +1,189 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidpb converts UUIDs to and from the UUID message of uuid.proto,
+// two fixed64 fields, so gRPC APIs can pass UUIDs as 18 bytes instead of 38
+// byte strings.
+//
+// The package does not depend on the protocol buffer runtime, and uuid.proto
+// declares no go_package: copy or import it into the protos of an API and
+// generate its Go code in a package of the API.  FromProto and Validate
+// accept the generated messages, which have GetHi and GetLo methods, and the
+// fields of ToProto fill them:
+//
+//	p := uuidpb.ToProto(id)
+//	req := &apipb.GetUserRequest{UserId: &apipb.UUID{Hi: p.Hi, Lo: p.Lo}}
+//
+// The UUID type of this package is a plain helper for the wire format of the
+// message, for code without generated messages.  It is not a proto.Message
+// and cannot be a field of a generated message.
+package uuidpb
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNilMessage is returned by FromProto and Validate for a nil message.
+	ErrNilMessage = errors.New("uuidpb: nil message")
+
+	// ErrVariant is returned by Validate for a UUID that is not of the
+	// RFC 9562 variant and is neither Nil nor Max.
+	ErrVariant = errors.New("uuidpb: UUID must be of the RFC 9562 variant, Nil or Max")
+
+	// ErrWireFormat is returned by Unmarshal for malformed input.
+	ErrWireFormat = errors.New("uuidpb: invalid wire format")
+)
+
+// Message is implemented by the UUID type of this package and by the code
+// generated from uuid.proto.
+type Message interface {
+	GetHi() uint64
+	GetLo() uint64
+}
+
+// UUID holds the fields of the UUID message of uuid.proto, and encodes them in
+// the protocol buffer wire format with Marshal and Unmarshal.  It is not a
+// generated message: see the package documentation.
+type UUID struct {
+	Hi uint64
+	Lo uint64
+}
+
+// GetHi returns the high 64 bits of the UUID or 0 if m is nil.
+func (m *UUID) GetHi() uint64 {
+	if m == nil {
+		return 0
+	}
+	return m.Hi
+}
+
+// GetLo returns the low 64 bits of the UUID or 0 if m is nil.
+func (m *UUID) GetLo() uint64 {
+	if m == nil {
+		return 0
+	}
+	return m.Lo
+}
+
+// ToProto returns the message for u.
+func ToProto(u uuid.UUID) *UUID {
+	return &UUID{
+		Hi: binary.BigEndian.Uint64(u[:8]),
+		Lo: binary.BigEndian.Uint64(u[8:]),
+	}
+}
+
+// FromProto returns the UUID of m.  It returns ErrNilMessage if m is nil.
+func FromProto(m Message) (uuid.UUID, error) {
+	if isNil(m) {
+		return uuid.Nil, ErrNilMessage
+	}
+	var u uuid.UUID
+	binary.BigEndian.PutUint64(u[:8], m.GetHi())
+	binary.BigEndian.PutUint64(u[8:], m.GetLo())
+	return u, nil
+}
+
+// Validate applies the rule of uuid.proto enforced by protovalidate to m: m
+// must not be nil and must hold a UUID of the RFC 9562 variant, Nil or Max.
+func Validate(m Message) error {
+	u, err := FromProto(m)
+	if err != nil {
+		return err
+	}
+	if u.Variant() != uuid.RFC4122 && u != uuid.Nil && u != uuid.Max {
+		return ErrVariant
+	}
+	return nil
+}
+
+// isNil reports whether m is nil or a nil pointer, such as a nil *UUID or a
+// nil pointer to a message generated by protoc-gen-go.
+func isNil(m Message) bool {
+	if m == nil {
+		return true
+	}
+	v := reflect.ValueOf(m)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// Protocol buffer tags of the fields hi and lo, both of wire type fixed64.
+const (
+	tagHi = 1<<3 | wireFixed64
+	tagLo = 2<<3 | wireFixed64
+)
+
+// Protocol buffer wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// Marshal returns the protocol buffer wire encoding of m.  As in proto3,
+// fields that are 0 are omitted.
+func (m *UUID) Marshal() ([]byte, error) {
+	b := make([]byte, 0, 18)
+	var buf [9]byte
+	if hi := m.GetHi(); hi != 0 {
+		buf[0] = tagHi
+		binary.LittleEndian.PutUint64(buf[1:], hi)
+		b = append(b, buf[:]...)
+	}
+	if lo := m.GetLo(); lo != 0 {
+		buf[0] = tagLo
+		binary.LittleEndian.PutUint64(buf[1:], lo)
+		b = append(b, buf[:]...)
+	}
+	return b, nil
+}
+
+// Unmarshal sets m from the protocol buffer wire encoding in b.  Unknown
+// fields are skipped.
+func (m *UUID) Unmarshal(b []byte) error {
+	*m = UUID{}
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return ErrWireFormat
+		}
+		b = b[n:]
+		switch key & 7 {
+		case wireVarint:
+			if _, n = binary.Uvarint(b); n <= 0 {
+				return ErrWireFormat
+			}
+		case wireFixed64:
+			if n = 8; len(b) < n {
+				return ErrWireFormat
+			}
+			switch key {
+			case tagHi:
+				m.Hi = binary.LittleEndian.Uint64(b)
+			case tagLo:
+				m.Lo = binary.LittleEndian.Uint64(b)
+			}
+		case wireBytes:
+			l, k := binary.Uvarint(b)
+			if k <= 0 || l > uint64(len(b)-k) {
+				return ErrWireFormat
+			}
+			n = k + int(l)
+		case wireFixed32:
+			if n = 4; len(b) < n {
+				return ErrWireFormat
+			}
+		default:
+			return ErrWireFormat
+		}
+		b = b[n:]
+	}
+	return nil
+}
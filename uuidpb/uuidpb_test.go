@@ -0,0 +1,136 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidpb
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestProto(t *testing.T) {
+	u := uuid.MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	m := ToProto(u)
+	if m.Hi != 0xf47ac10b58cc4372 || m.Lo != 0x85670e02b2c3d479 {
+		t.Errorf("ToProto = %#x, %#x", m.Hi, m.Lo)
+	}
+	got, err := FromProto(m)
+	if err != nil || got != u {
+		t.Errorf("FromProto = %s, %v, want %s", got, err, u)
+	}
+	if _, err := FromProto((*UUID)(nil)); err != ErrNilMessage {
+		t.Errorf("FromProto(nil) got error %v, want %v", err, ErrNilMessage)
+	}
+	if _, err := FromProto(nil); err != ErrNilMessage {
+		t.Errorf("FromProto(nil) got error %v, want %v", err, ErrNilMessage)
+	}
+	if _, err := FromProto((*genUUID)(nil)); err != ErrNilMessage {
+		t.Errorf("FromProto(typed nil) got error %v, want %v", err, ErrNilMessage)
+	}
+}
+
+// genUUID is a message as generated by protoc-gen-go, with nil safe getters.
+type genUUID struct{ Hi, Lo uint64 }
+
+func (m *genUUID) GetHi() uint64 {
+	if m == nil {
+		return 0
+	}
+	return m.Hi
+}
+
+func (m *genUUID) GetLo() uint64 {
+	if m == nil {
+		return 0
+	}
+	return m.Lo
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		m   Message
+		err error
+	}{
+		{ToProto(uuid.MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")), nil},
+		{ToProto(uuid.Nil), nil},
+		{ToProto(uuid.Max), nil},
+		{ToProto(uuid.MustParse("f47ac10b-58cc-4372-c567-0e02b2c3d479")), ErrVariant},
+		{(*UUID)(nil), ErrNilMessage},
+		{(*genUUID)(nil), ErrNilMessage},
+		{&genUUID{Hi: 0xf47ac10b58cc4372, Lo: 0x85670e02b2c3d479}, nil},
+	} {
+		if err := Validate(tt.m); err != tt.err {
+			t.Errorf("Validate(%v) got error %v, want %v", tt.m, err, tt.err)
+		}
+	}
+}
+
+// TestRule checks that the variant rule of uuid.proto, a CEL expression
+// protovalidate evaluates, accepts the same lo fields as Validate.
+func TestRule(t *testing.T) {
+	b, err := os.ReadFile("uuid.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := regexp.MustCompile(`expression: "\(this\.lo >= (\d+)u && this\.lo < (\d+)u\) \|\|`).FindSubmatch(b)
+	if m == nil {
+		t.Fatal("variant range not found in uuid.proto")
+	}
+	lo, _ := strconv.ParseUint(string(m[1]), 10, 64)
+	hi, _ := strconv.ParseUint(string(m[2]), 10, 64)
+	for _, v := range []uint64{0, 1 << 62, 1<<63 - 1, 1 << 63, 0xbfffffffffffffff, 0xc000000000000000, 1<<64 - 1} {
+		want := Validate(&UUID{Hi: 1, Lo: v}) == nil
+		if got := v >= lo && v < hi; got != want {
+			t.Errorf("rule accepts lo %#x: %v, Validate: %v", v, got, want)
+		}
+	}
+}
+
+func TestWireFormat(t *testing.T) {
+	m := ToProto(uuid.MustParse("01020304-0506-0708-090a-0b0c0d0e0f10"))
+	b, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01,
+		0x11, 0x10, 0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09,
+	}
+	if !bytes.Equal(b, want) {
+		t.Errorf("Marshal = %x, want %x", b, want)
+	}
+	var got UUID
+	if err := got.Unmarshal(b); err != nil || got != *m {
+		t.Errorf("Unmarshal = %+v, %v, want %+v", got, err, *m)
+	}
+	if b, _ := (&UUID{}).Marshal(); len(b) != 0 {
+		t.Errorf("Marshal of zero UUID = %x, want no bytes", b)
+	}
+
+	// Unknown fields of all wire types are skipped.
+	unknown := append([]byte{
+		0x18, 0x96, 0x01, // field 3 varint
+		0x21, 1, 2, 3, 4, 5, 6, 7, 8, // field 4 fixed64
+		0x2a, 0x02, 'h', 'i', // field 5 bytes
+		0x35, 1, 2, 3, 4, // field 6 fixed32
+	}, want...)
+	if err := got.Unmarshal(unknown); err != nil || got != *m {
+		t.Errorf("Unmarshal with unknown fields = %+v, %v, want %+v", got, err, *m)
+	}
+	for _, b := range [][]byte{
+		{0x09, 1, 2},
+		{0x2a, 0x05, 'h'},
+		{0x0b},
+		{0x80},
+	} {
+		if err := got.Unmarshal(b); err != ErrWireFormat {
+			t.Errorf("Unmarshal(%x) got error %v, want %v", b, err, ErrWireFormat)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidsqlite registers SQL functions converting UUIDs between the
+// BLOB(16) and TEXT forms with the SQLite drivers github.com/mattn/go-sqlite3
+// and modernc.org/sqlite:
+//
+//	uuid_blob(x)    x, a UUID as TEXT or BLOB, as a BLOB of 16 bytes
+//	uuid_text(x)    x, a UUID as TEXT or BLOB, as TEXT in the standard form
+//
+// Both functions return NULL for NULL and accept all the forms accepted by
+// uuid.Parse, so that columns of either type can be compared and migrated in
+// SQL:
+//
+//	SELECT uuid_text(id) FROM orders WHERE id = uuid_blob(?)
+//
+// uuid.UUID implements sql.Scanner for both forms, but its Value is TEXT.
+// Blob is a UUID whose Value is a BLOB of 16 bytes, for writing to BLOB(16)
+// columns without per-query casts:
+//
+//	db.Exec("INSERT INTO orders (id) VALUES (?)", uuidsqlite.Blob(id))
+//
+// With github.com/mattn/go-sqlite3 the functions are registered on each
+// connection by a ConnectHook:
+//
+//	sql.Register("sqlite3_uuid", &sqlite3.SQLiteDriver{
+//		ConnectHook: func(c *sqlite3.SQLiteConn) error {
+//			return uuidsqlite.Register(c)
+//		},
+//	})
+//
+// With modernc.org/sqlite RegisterModernc registers them once for all
+// connections opened afterwards.
+//
+// The package is a separate module so that the uuid package itself does not
+// depend on a SQLite driver.
+package uuidsqlite
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/google/uuid"
+	"modernc.org/sqlite"
+)
+
+// Blob is a UUID stored in databases as a BLOB of its 16 bytes.
+type Blob uuid.UUID
+
+// Value implements driver.Valuer.
+func (b Blob) Value() (driver.Value, error) {
+	return b[:], nil
+}
+
+// Scan implements sql.Scanner.  Both the BLOB and the TEXT forms are accepted.
+func (b *Blob) Scan(src interface{}) error {
+	return (*uuid.UUID)(b).Scan(src)
+}
+
+// A FuncRegisterer registers SQL functions on a connection, as
+// *sqlite3.SQLiteConn of github.com/mattn/go-sqlite3 does.
+type FuncRegisterer interface {
+	RegisterFunc(name string, impl interface{}, pure bool) error
+}
+
+// Register registers the functions of the package on conn.  It is meant to
+// be called from the ConnectHook of a github.com/mattn/go-sqlite3 driver.
+func Register(conn FuncRegisterer) error {
+	for name, fn := range functions {
+		if err := conn.RegisterFunc(name, fn, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterModernc registers the functions of the package with
+// modernc.org/sqlite.  They are available to all the connections opened
+// afterwards.  RegisterModernc returns an error if called more than once.
+func RegisterModernc() error {
+	for name, fn := range functions {
+		fn := fn
+		err := sqlite.RegisterDeterministicScalarFunction(name, 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			return fn(args[0])
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var functions = map[string]func(interface{}) (interface{}, error){
+	"uuid_blob": toBlob,
+	"uuid_text": toText,
+}
+
+// convert returns the UUID of x, a TEXT or BLOB value, and false if x is
+// NULL.  An empty BLOB is taken as NULL, as github.com/mattn/go-sqlite3 passes
+// NULL as a nil []byte.
+func convert(name string, x interface{}) (uuid.UUID, bool, error) {
+	var u uuid.UUID
+	switch x := x.(type) {
+	case nil:
+		return u, false, nil
+	case string:
+		u, err := uuid.Parse(x)
+		if err != nil {
+			return u, false, fmt.Errorf("%s: %v", name, err)
+		}
+		return u, true, nil
+	case []byte:
+		switch len(x) {
+		case 0:
+			return u, false, nil
+		case 16:
+			copy(u[:], x)
+			return u, true, nil
+		}
+		u, err := uuid.ParseBytes(x)
+		if err != nil {
+			return u, false, fmt.Errorf("%s: %v", name, err)
+		}
+		return u, true, nil
+	}
+	return u, false, fmt.Errorf("%s: unable to convert type %T to UUID", name, x)
+}
+
+func toBlob(x interface{}) (interface{}, error) {
+	u, ok, err := convert("uuid_blob", x)
+	if !ok {
+		return nil, err
+	}
+	return u[:], nil
+}
+
+func toText(x interface{}) (interface{}, error) {
+	u, ok, err := convert("uuid_text", x)
+	if !ok {
+		return nil, err
+	}
+	return u.String(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidsqlite
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mattn/go-sqlite3"
+	_ "modernc.org/sqlite"
+)
+
+func init() {
+	sql.Register("sqlite3_uuid", &sqlite3.SQLiteDriver{
+		ConnectHook: func(c *sqlite3.SQLiteConn) error {
+			return Register(c)
+		},
+	})
+	if err := RegisterModernc(); err != nil {
+		panic(err)
+	}
+}
+
+func TestDrivers(t *testing.T) {
+	for _, name := range []string{"sqlite3_uuid", "sqlite"} {
+		t.Run(name, func(t *testing.T) {
+			testDriver(t, name)
+		})
+	}
+}
+
+func testDriver(t *testing.T, driverName string) {
+	db, err := sql.Open(driverName, ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("CREATE TABLE t (b BLOB, s TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+	id := uuid.MustParse("f47ac10b-58cc-4372-8567-0e02b2c3d479")
+	if _, err := db.Exec("INSERT INTO t VALUES (?, ?)", Blob(id), id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO t VALUES (NULL, NULL)"); err != nil {
+		t.Fatal(err)
+	}
+
+	var n int
+	if err := db.QueryRow("SELECT length(b) FROM t WHERE b IS NOT NULL").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 16 {
+		t.Errorf("BLOB column holds %d bytes, want 16", n)
+	}
+
+	var b Blob
+	var s uuid.UUID
+	if err := db.QueryRow("SELECT b, s FROM t WHERE b = uuid_blob(s)").Scan(&b, &s); err != nil {
+		t.Fatal(err)
+	}
+	if uuid.UUID(b) != id || s != id {
+		t.Errorf("got %s and %s, want %s", uuid.UUID(b), s, id)
+	}
+
+	var text string
+	if err := db.QueryRow("SELECT uuid_text(b) FROM t WHERE s = ?", id).Scan(&text); err != nil {
+		t.Fatal(err)
+	}
+	if text != id.String() {
+		t.Errorf("uuid_text returned %q, want %q", text, id)
+	}
+	if err := db.QueryRow("SELECT uuid_text(?)", "{"+id.String()+"}").Scan(&text); err != nil {
+		t.Fatal(err)
+	}
+	if text != id.String() {
+		t.Errorf("uuid_text of a braced UUID returned %q, want %q", text, id)
+	}
+
+	var null sql.NullString
+	if err := db.QueryRow("SELECT uuid_text(b) FROM t WHERE b IS NULL").Scan(&null); err != nil {
+		t.Fatal(err)
+	}
+	if null.Valid {
+		t.Errorf("uuid_text(NULL) returned %q, want NULL", null.String)
+	}
+
+	if err := db.QueryRow("SELECT uuid_blob('not a uuid')").Scan(&b); err == nil {
+		t.Errorf("uuid_blob of an invalid UUID did not fail")
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// pathological are strings that resemble UUIDs but must be rejected by
+// strict parsers: embedded NULs, overlong URNs, Unicode dashes and digits
+// and surrounding white space.
+var pathological = []string{
+	"f47ac10b-58cc-0372-8567-0e02b2c3d47\x00",
+	"f47ac10b\x0058cc-0372-8567-0e02b2c3d479",
+	"urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d4790",
+	"urn:uuid:urn:uuid:f47ac10b-58cc-0372-8567-0e02b2c3d479",
+	"urn:uuid\x00f47ac10b-58cc-0372-8567-0e02b2c3d479",
+	"f47ac10b\u201058cc-0372-8567-0e02b2c3d479",
+	"f47ac10b\u201358cc\u20130372-8567-0e02b2c3d479",
+	"f47ac10b\u221258cc-0372-8567-0e02b2c3d479",
+	"f47ac10b-58cc-0372-8567-0e02b2c3d4\uff17\uff19",
+	"\ufefff47ac10b-58cc-0372-8567-0e02b2c3d479",
+	" f47ac10b-58cc-0372-8567-0e02b2c3d479",
+	"f47ac10b-58cc-0372-8567-0e02b2c3d479\n",
+	"+f47ac10-58cc-0372-8567-0e02b2c3d479",
+	"",
+}
+
+// corpusMalformed is the number of strings of Malformed in FuzzCorpus.
+const corpusMalformed = 64
+
+// FuzzCorpus returns seed inputs for fuzz tests of code parsing UUIDs, such as
+//
+//	func FuzzParseID(f *testing.F) {
+//		for _, s := range uuidtest.FuzzCorpus() {
+//			f.Add(s)
+//		}
+//		f.Fuzz(func(t *testing.T, s string) { ... })
+//	}
+//
+// The corpus holds each UUID of EdgeCases in every form accepted by
+// uuid.Parse, in lower and upper case, strings of Malformed, and
+// pathological strings resembling UUIDs: embedded NULs, overlong URNs,
+// Unicode dashes and digits, and surrounding white space.  The corpus is the
+// same in every call.
+func FuzzCorpus() []string {
+	var corpus []string
+	for _, u := range EdgeCases() {
+		s := u.String()
+		for _, form := range []string{
+			s, u.URN(), "{" + s + "}", strings.Replace(s, "-", "", -1),
+		} {
+			corpus = append(corpus, form, strings.ToUpper(form))
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < corpusMalformed; i++ {
+		corpus = append(corpus, Malformed(r))
+	}
+	return append(corpus, pathological...)
+}
+
+// WriteFuzzCorpus writes the inputs of FuzzCorpus to dir, one file per input,
+// in the corpus file format of the go command, so that dir can be used as the
+// seed corpus, testdata/fuzz/FuzzName, of a fuzz test taking a single string.
+// The directory is created if necessary.
+func WriteFuzzCorpus(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for i, s := range FuzzCorpus() {
+		data := fmt.Sprintf("go test fuzz v1\nstring(%q)\n", s)
+		name := filepath.Join(dir, fmt.Sprintf("uuidtest-%03d", i))
+		if err := os.WriteFile(name, []byte(data), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFuzzCorpus(t *testing.T) {
+	corpus := FuzzCorpus()
+	if !reflect.DeepEqual(corpus, FuzzCorpus()) {
+		t.Errorf("FuzzCorpus is not deterministic")
+	}
+	valid := 0
+	for _, s := range corpus {
+		if _, err := uuid.Parse(s); err == nil {
+			valid++
+		}
+	}
+	if want := len(EdgeCases()) * 8; valid != want {
+		t.Errorf("%d inputs are valid, want %d", valid, want)
+	}
+	for _, s := range pathological {
+		if _, err := uuid.Parse(s); err == nil {
+			t.Errorf("Parse(%q) succeeded", s)
+		}
+	}
+}
+
+func TestWriteFuzzCorpus(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "testdata", "fuzz", "FuzzParse")
+	if err := WriteFuzzCorpus(dir); err != nil {
+		t.Fatal(err)
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corpus := FuzzCorpus()
+	if len(files) != len(corpus) {
+		t.Fatalf("wrote %d files, want %d", len(files), len(corpus))
+	}
+	data, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(string(data), "\n")
+	if lines[0] != "go test fuzz v1" || !strings.HasPrefix(lines[1], "string(") {
+		t.Fatalf("corpus file %q", data)
+	}
+	s, err := strconv.Unquote(strings.TrimSuffix(strings.TrimPrefix(lines[1], "string("), ")"))
+	if err != nil || s != corpus[0] {
+		t.Errorf("corpus file holds %q, %v, want %q", s, err, corpus[0])
+	}
+}
+
+// FuzzParse shows the use of FuzzCorpus, checking that the strings accepted
+// by uuid.Parse and uuid.ParseBytes are the same.
+func FuzzParse(f *testing.F) {
+	for _, s := range FuzzCorpus() {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		u, err := uuid.Parse(s)
+		if b, errb := uuid.ParseBytes([]byte(s)); b != u || (err == nil) != (errb == nil) {
+			t.Fatalf("Parse(%q) = %s, %v but ParseBytes = %s, %v", s, u, err, b, errb)
+		}
+	})
+}
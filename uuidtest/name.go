@@ -0,0 +1,23 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// NameSpace is the namespace of the UUIDs of FromName.
+var NameSpace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://pkg.go.dev/github.com/google/uuid/uuidtest"))
+
+// FromName returns a Version 5 UUID derived from the name of the test t and
+// label.  The UUID is the same in every run of the test, but differs between
+// tests and labels, so parallel tests receive distinct, reproducible UUIDs
+// without a shared seed.
+func FromName(t testing.TB, label string) uuid.UUID {
+	t.Helper()
+	return uuid.NewSHA1(NameSpace, []byte(t.Name()+"\x00"+label))
+}
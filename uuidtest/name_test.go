@@ -0,0 +1,33 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestFromName(t *testing.T) {
+	a := FromName(t, "account")
+	if a != FromName(t, "account") {
+		t.Errorf("FromName is not stable")
+	}
+	if a == FromName(t, "order") {
+		t.Errorf("FromName returned the same UUID for different labels")
+	}
+	if a.Version() != 5 || a.Variant() != uuid.RFC4122 {
+		t.Errorf("FromName returned %s, want a Version 5 UUID", a)
+	}
+	want := uuid.NewSHA1(NameSpace, []byte("TestFromName\x00account"))
+	if a != want {
+		t.Errorf("FromName = %s, want %s", a, want)
+	}
+	t.Run("sub", func(t *testing.T) {
+		if FromName(t, "account") == a {
+			t.Errorf("subtest received the UUID of its parent")
+		}
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// A Sequence returns the UUIDs of Nth in order, starting with 1, so that
+// fixtures and golden files are stable and easy to read.  A Sequence is safe
+// for concurrent use by multiple goroutines.
+type Sequence struct {
+	mu sync.Mutex
+	n  uint64 // protected by mu
+}
+
+// Sequential returns a new Sequence.
+func Sequential() *Sequence {
+	return &Sequence{}
+}
+
+// Next returns the next UUID of s.
+func (s *Sequence) Next() uuid.UUID {
+	s.mu.Lock()
+	s.n++
+	n := s.n
+	s.mu.Unlock()
+	return Nth(n)
+}
+
+// NewUUID is like Next but has the signature of uuid.NewRandom, so s can
+// replace a UUID generator in code under test.
+func (s *Sequence) NewUUID() (uuid.UUID, error) {
+	return s.Next(), nil
+}
+
+// Nth returns the Version 4 UUID with the number n in its last 62 bits:
+//
+//	Nth(1)   = 00000000-0000-4000-8000-000000000001
+//	Nth(255) = 00000000-0000-4000-8000-0000000000ff
+func Nth(n uint64) uuid.UUID {
+	var u uuid.UUID
+	u[6] = 0x40
+	binary.BigEndian.PutUint64(u[8:], n&(1<<62-1)|0x8000000000000000)
+	return u
+}
@@ -0,0 +1,31 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSequential(t *testing.T) {
+	s := Sequential()
+	for _, want := range []string{
+		"00000000-0000-4000-8000-000000000001",
+		"00000000-0000-4000-8000-000000000002",
+		"00000000-0000-4000-8000-000000000003",
+	} {
+		if got := s.Next().String(); got != want {
+			t.Errorf("Next() = %s, want %s", got, want)
+		}
+	}
+	if u, err := s.NewUUID(); err != nil || u != Nth(4) {
+		t.Errorf("NewUUID() = %s, %v, want %s", u, err, Nth(4))
+	}
+	u := Nth(1<<62 - 1)
+	if u.Version() != 4 || u.Variant() != uuid.RFC4122 {
+		t.Errorf("Nth(1<<62 - 1) = %s is not a valid Version 4 UUID", u)
+	}
+}
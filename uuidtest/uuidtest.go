@@ -0,0 +1,107 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidtest provides UUIDs for tests: generators of valid UUIDs, edge
+// cases and malformed strings for property based tests with testing/quick or
+// with packages such as pgregory.net/rapid, which can wrap the functions
+// taking a *rand.Rand.
+package uuidtest
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// edgeChance is the chance, 1 in edgeChance, that Valid generates an edge
+// case rather than a random UUID.
+const edgeChance = 8
+
+// Random returns a random UUID of the RFC 9562 variant and of one of
+// versions, or of any version from 1 to 8 if versions is empty.  All bits
+// other than the version and variant are random; time based versions are not
+// given meaningful times.
+func Random(r *rand.Rand, versions ...uuid.Version) uuid.UUID {
+	var u uuid.UUID
+	r.Read(u[:])
+	v := uuid.Version(r.Intn(8) + 1)
+	if len(versions) > 0 {
+		v = versions[r.Intn(len(versions))]
+	}
+	u[6] = u[6]&0x0f | byte(v)<<4
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// EdgeCases returns UUIDs at the edges of the UUID space: Nil, Max, and for
+// each version from 1 to 8 the smallest and largest UUID of the version and
+// of the RFC 9562 variant.
+func EdgeCases() []uuid.UUID {
+	edges := []uuid.UUID{uuid.Nil, uuid.Max}
+	for v := byte(1); v <= 8; v++ {
+		var lo, hi uuid.UUID
+		for i := range hi {
+			hi[i] = 0xff
+		}
+		lo[6], hi[6] = v<<4, v<<4|0x0f
+		lo[8], hi[8] = 0x80, 0xbf
+		edges = append(edges, lo, hi)
+	}
+	return edges
+}
+
+// Malformed returns a random string that uuid.Parse rejects, derived from a
+// valid UUID by one of a set of mistakes: a wrong length, a misplaced
+// hyphen, a character that is not a hex digit, or a wrong URN prefix.
+func Malformed(r *rand.Rand) string {
+	for {
+		s := malform(r, Random(r).String())
+		if _, err := uuid.Parse(s); err != nil {
+			return s
+		}
+	}
+}
+
+func malform(r *rand.Rand, s string) string {
+	switch r.Intn(6) {
+	case 0: // too short
+		return s[:r.Intn(len(s))]
+	case 1: // too long
+		return s + s[:1+r.Intn(8)]
+	case 2: // misplaced hyphen
+		i := r.Intn(len(s) - 1)
+		return s[:i] + s[i+1:i+2] + s[i:i+1] + s[i+2:]
+	case 3: // not a hex digit
+		i := r.Intn(len(s))
+		return s[:i] + string("gxzG _!\x00"[r.Intn(8)]) + s[i+1:]
+	case 4: // wrong URN prefix
+		return []string{"urn:uid:", "urn:uuid-", "uri:uuid:", "urn:uuid;"}[r.Intn(4)] + s
+	default: // hyphens removed from part of the UUID
+		return strings.Replace(s, "-", "", 1+r.Intn(3))
+	}
+}
+
+// Valid is a UUID implementing testing/quick.Generator.  Valid values are
+// random UUIDs of Random or, one time in 8, edge cases of EdgeCases.
+type Valid uuid.UUID
+
+// Generate implements testing/quick.Generator.
+func (Valid) Generate(r *rand.Rand, size int) reflect.Value {
+	if r.Intn(edgeChance) == 0 {
+		edges := EdgeCases()
+		return reflect.ValueOf(Valid(edges[r.Intn(len(edges))]))
+	}
+	return reflect.ValueOf(Valid(Random(r)))
+}
+
+// MalformedString is a string implementing testing/quick.Generator with
+// values of Malformed.
+type MalformedString string
+
+// Generate implements testing/quick.Generator.
+func (MalformedString) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(MalformedString(Malformed(r)))
+}
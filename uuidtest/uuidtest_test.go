@@ -0,0 +1,58 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtest
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/google/uuid"
+)
+
+func TestRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		u := Random(r, 4, 7)
+		if v := u.Version(); v != 4 && v != 7 {
+			t.Fatalf("Random(4, 7) returned version %d", v)
+		}
+		if u.Variant() != uuid.RFC4122 {
+			t.Fatalf("Random returned variant %v", u.Variant())
+		}
+	}
+}
+
+func TestEdgeCases(t *testing.T) {
+	edges := EdgeCases()
+	if len(edges) != 18 {
+		t.Errorf("got %d edge cases, want 18", len(edges))
+	}
+	for _, u := range edges[2:] {
+		if u.Variant() != uuid.RFC4122 {
+			t.Errorf("edge case %s has variant %v", u, u.Variant())
+		}
+	}
+	if s := edges[len(edges)-1].String(); s != "ffffffff-ffff-8fff-bfff-ffffffffffff" {
+		t.Errorf("largest Version 8 UUID is %s", s)
+	}
+}
+
+func TestQuick(t *testing.T) {
+	roundTrip := func(v Valid) bool {
+		u, err := uuid.Parse(uuid.UUID(v).String())
+		return err == nil && u == uuid.UUID(v)
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+	rejected := func(s MalformedString) bool {
+		_, err := uuid.Parse(string(s))
+		return err != nil
+	}
+	if err := quick.Check(rejected, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidtext provides helpers for using UUIDs in text/template and
+// html/template templates and in CSV files read and written with
+// encoding/csv.
+package uuidtext
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/google/uuid"
+)
+
+// shortLen is the length of the strings returned by the uuidShort template
+// function.
+const shortLen = 8
+
+// FuncMap returns the template functions
+//
+//	uuidv4              a new Random (Version 4) UUID
+//	uuidv7              a new Version 7 UUID
+//	uuidParse STRING    the UUID parsed from STRING
+//	uuidShort UUID      the first 8 hex digits of UUID, which may also be a string
+//
+// for use with the Funcs method of text/template and html/template:
+//
+//	t := template.New("report").Funcs(uuidtext.FuncMap())
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"uuidv4":    uuid.NewRandom,
+		"uuidv7":    uuid.NewV7,
+		"uuidParse": uuid.Parse,
+		"uuidShort": short,
+	}
+}
+
+func short(v interface{}) (string, error) {
+	var id uuid.UUID
+	switch v := v.(type) {
+	case uuid.UUID:
+		id = v
+	case string:
+		var err error
+		if id, err = uuid.Parse(v); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("uuidShort: unsupported type %T", v)
+	}
+	return id.String()[:shortLen], nil
+}
+
+// FormatRecord returns the string form of uuids as a CSV record for
+// csv.Writer.Write.
+func FormatRecord(uuids []uuid.UUID) []string {
+	return uuid.FormatSlice(uuids, uuid.StyleCanonical)
+}
+
+// ParseRecord parses the fields of a CSV record, as returned by csv.Reader.Read,
+// as UUIDs.  Leading and trailing spaces of the fields are not allowed unless
+// the csv.Reader trims them.
+func ParseRecord(record []string) ([]uuid.UUID, error) {
+	return uuid.ParseSlice(record)
+}
+
+// FormatNull returns the CSV field for nu: the string form of the UUID, or
+// the empty string if nu is not valid.
+func FormatNull(nu uuid.NullUUID) string {
+	if !nu.Valid {
+		return ""
+	}
+	return nu.UUID.String()
+}
+
+// ParseNull parses the CSV field s as a NullUUID.  The empty string is parsed
+// as a NullUUID that is not valid.
+func ParseNull(s string) (uuid.NullUUID, error) {
+	if s == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidtext
+
+import (
+	"bytes"
+	"encoding/csv"
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/uuid"
+)
+
+func TestFuncMap(t *testing.T) {
+	const text = `{{uuidv4 | uuidShort}} {{(uuidv7).Version}} {{uuidParse .ID}} {{uuidShort .ID}}`
+	id := uuid.NameSpaceDNS.String()
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("t").Funcs(FuncMap()).Parse(text))
+	if err := tmpl.Execute(&buf, map[string]string{"ID": id}); err != nil {
+		t.Fatal(err)
+	}
+	fields := strings.Fields(buf.String())
+	if len(fields) != 4 {
+		t.Fatalf("got %q", buf.String())
+	}
+	if len(fields[0]) != shortLen {
+		t.Errorf("uuidv4 | uuidShort = %q", fields[0])
+	}
+	if fields[1] != "VERSION_7" {
+		t.Errorf("(uuidv7).Version = %q, want VERSION_7", fields[1])
+	}
+	if fields[2] != id {
+		t.Errorf("uuidParse = %q, want %q", fields[2], id)
+	}
+	if fields[3] != id[:8] {
+		t.Errorf("uuidShort = %q, want %q", fields[3], id[:8])
+	}
+
+	buf.Reset()
+	htmpl := htmltemplate.Must(htmltemplate.New("t").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(`{{uuidShort .}}`))
+	if err := htmpl.Execute(&buf, uuid.NameSpaceDNS); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != id[:8] {
+		t.Errorf("html/template uuidShort = %q, want %q", buf.String(), id[:8])
+	}
+
+	if err := template.Must(template.New("t").Funcs(FuncMap()).Parse(`{{uuidShort 1}}`)).Execute(&buf, nil); err == nil {
+		t.Errorf("uuidShort 1 succeeded")
+	}
+}
+
+func TestRecord(t *testing.T) {
+	uuids := []uuid.UUID{uuid.NameSpaceDNS, uuid.NameSpaceURL}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(FormatRecord(uuids)); err != nil {
+		t.Fatal(err)
+	}
+	w.Flush()
+	record, err := csv.NewReader(&buf).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParseRecord(record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != uuids[0] || got[1] != uuids[1] {
+		t.Errorf("got %v, want %v", got, uuids)
+	}
+}
+
+func TestNull(t *testing.T) {
+	for _, nu := range []uuid.NullUUID{{}, {UUID: uuid.NameSpaceDNS, Valid: true}} {
+		got, err := ParseNull(FormatNull(nu))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != nu {
+			t.Errorf("got %v, want %v", got, nu)
+		}
+	}
+	if _, err := ParseNull("x"); err == nil {
+		t.Errorf("ParseNull(%q) succeeded", "x")
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package uuidvalidator registers UUID validation tags with
+// github.com/go-playground/validator, backed by the parser of the uuid
+// package so request validation and the rest of a program agree on what a
+// UUID is:
+//
+//	uuid_strict       a UUID in the standard form of RFC 9562
+//	                  (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx) of the RFC 9562
+//	                  variant, or Nil or Max
+//	uuidv7            a uuid_strict UUID of Version 7
+//	uuid_version=N    a uuid_strict UUID of Version N
+//
+// The tags apply to string fields and to fields of type uuid.UUID, for which
+// only the variant and version are checked:
+//
+//	type Request struct {
+//		ID      string    `validate:"uuidv7"`
+//		Account uuid.UUID `validate:"uuid_version=4"`
+//	}
+//
+//	v := validator.New()
+//	if err := uuidvalidator.Register(v); err != nil {
+//		...
+//	}
+//
+// The package is a separate module so that the uuid package itself does not
+// depend on the validator.
+package uuidvalidator
+
+import (
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Register registers the tags of the package with v.
+func Register(v *validator.Validate) error {
+	for tag, fn := range map[string]validator.Func{
+		"uuid_strict":  validateStrict,
+		"uuidv7":       validateV7,
+		"uuid_version": validateVersion,
+	} {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// field returns the UUID of the field of fl.
+func field(fl validator.FieldLevel) (uuid.UUID, bool) {
+	switch f := fl.Field().Interface().(type) {
+	case uuid.UUID:
+		return f, true
+	case string:
+		u, err := uuid.ParseFormat(f, uuid.FormatCanonical)
+		return u, err == nil
+	}
+	return uuid.Nil, false
+}
+
+// strict returns the UUID of the field of fl if it is a strict UUID.
+func strict(fl validator.FieldLevel) (uuid.UUID, bool) {
+	u, ok := field(fl)
+	if !ok {
+		return u, false
+	}
+	return u, u.Variant() == uuid.RFC4122 || u == uuid.Nil || u == uuid.Max
+}
+
+func validateStrict(fl validator.FieldLevel) bool {
+	_, ok := strict(fl)
+	return ok
+}
+
+func validateV7(fl validator.FieldLevel) bool {
+	u, ok := strict(fl)
+	return ok && u.Version() == 7
+}
+
+func validateVersion(fl validator.FieldLevel) bool {
+	v, err := strconv.ParseUint(fl.Param(), 10, 4)
+	if err != nil {
+		panic("uuid_version: invalid version " + strconv.Quote(fl.Param()))
+	}
+	u, ok := strict(fl)
+	return ok && u.Version() == uuid.Version(v)
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuidvalidator
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+func TestRegister(t *testing.T) {
+	v := validator.New()
+	if err := Register(v); err != nil {
+		t.Fatal(err)
+	}
+	v4 := "f47ac10b-58cc-4372-8567-0e02b2c3d479"
+	v7 := "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"
+	for _, tt := range []struct {
+		value interface{}
+		tag   string
+		ok    bool
+	}{
+		{v4, "uuid_strict", true},
+		{uuid.Nil.String(), "uuid_strict", true},
+		{"{" + v4 + "}", "uuid_strict", false},
+		{"urn:uuid:" + v4, "uuid_strict", false},
+		{"f47ac10b58cc437285670e02b2c3d479", "uuid_strict", false},
+		{"f47ac10b-58cc-4372-c567-0e02b2c3d479", "uuid_strict", false},
+		{v7, "uuidv7", true},
+		{v4, "uuidv7", false},
+		{v4, "uuid_version=4", true},
+		{v7, "uuid_version=4", false},
+		{uuid.MustParse(v7), "uuidv7", true},
+		{uuid.MustParse(v4), "uuidv7", false},
+		{uuid.MustParse(v4), "uuid_version=4", true},
+		{42, "uuid_strict", false},
+	} {
+		err := v.Var(tt.value, tt.tag)
+		if ok := err == nil; ok != tt.ok {
+			t.Errorf("Var(%v, %q) = %v, want ok %v", tt.value, tt.tag, err, tt.ok)
+		}
+	}
+}
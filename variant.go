@@ -0,0 +1,31 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "crypto/sha256"
+
+// NCS is the variant of the UUIDs of the Apollo Network Computing System,
+// the predecessor of DCE, found in legacy data.  It is the Reserved variant
+// returned by Variant.
+const NCS = Reserved
+
+// NormalizeVariant returns uuid converted to the variant of RFC 9562, and the
+// variant of uuid, so that UUIDs of the NCS, Microsoft and Future variants
+// found in legacy data can be stored where RFC 9562 UUIDs are required.
+//
+// UUIDs of the RFC 9562 variant, as well as Nil and Max, are returned
+// unchanged.  All other UUIDs are converted to the Version 8 UUID of their
+// SHA-256 digest, in the name-based layout of RFC 9562, Appendix B.2.  As the
+// variant bits of a UUID cannot be changed without losing information, the
+// conversion cannot be reversed, but, with overwhelming probability, distinct
+// UUIDs are converted to distinct UUIDs.
+func NormalizeVariant(uuid UUID) (UUID, Variant) {
+	v := uuid.Variant()
+	if v == RFC4122 || uuid == Nil || uuid == Max {
+		return uuid, v
+	}
+	sum := sha256.Sum256(uuid[:])
+	return fromSum(sum[:]), v
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestNormalizeVariant(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		variant Variant
+		same    bool
+	}{
+		{"f47ac10b-58cc-4372-8567-0e02b2c3d479", RFC4122, true},
+		{"00000000-0000-0000-0000-000000000000", NCS, true},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", Future, true},
+		// An NCS UUID of an Apollo workstation.
+		{"333a2276-0000-0000-0d00-00809c000000", NCS, false},
+		// IID_IUnknown of COM.
+		{"00000000-0000-0000-c000-000000000046", Microsoft, false},
+		{"f47ac10b-58cc-4372-e567-0e02b2c3d479", Future, false},
+	} {
+		in := MustParse(tt.in)
+		got, v := NormalizeVariant(in)
+		if v != tt.variant {
+			t.Errorf("NormalizeVariant(%s) variant %s, want %s", in, v, tt.variant)
+		}
+		if (got == in) != tt.same {
+			t.Errorf("NormalizeVariant(%s) = %s", in, got)
+		}
+		if !tt.same && (got.Variant() != RFC4122 || got.Version() != 8) {
+			t.Errorf("NormalizeVariant(%s) = %s (variant %s, version %s)", in, got, got.Variant(), got.Version())
+		}
+		if again, _ := NormalizeVariant(got); again != got {
+			t.Errorf("NormalizeVariant(%s) is not idempotent: %s", got, again)
+		}
+	}
+}
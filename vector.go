@@ -0,0 +1,54 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/binary"
+
+// The functions in this file process each UUID as two 64 bit words rather
+// than byte by byte, which makes them considerably faster on large slices.
+
+// XORAll returns the exclusive or of all UUIDs in uuids, or Nil if uuids is
+// empty.  As the result does not depend on the order of uuids it can be used
+// as a cheap fingerprint of a set of distinct UUIDs.
+func XORAll(uuids []UUID) UUID {
+	var hi, lo uint64
+	for i := range uuids {
+		h, l := uint128(uuids[i])
+		hi ^= h
+		lo ^= l
+	}
+	return fromUint128(hi, lo)
+}
+
+// AndMask replaces each UUID of uuids in place by its bitwise and with mask.
+func AndMask(uuids []UUID, mask UUID) {
+	mhi, mlo := uint128(mask)
+	for i := range uuids {
+		u := uuids[i][:]
+		binary.BigEndian.PutUint64(u[:8], binary.BigEndian.Uint64(u[:8])&mhi)
+		binary.BigEndian.PutUint64(u[8:], binary.BigEndian.Uint64(u[8:])&mlo)
+	}
+}
+
+// CompareSlice appends Compare(a[i], b[i]) to dst for each index i of the
+// shorter of a and b and returns the extended slice.
+func CompareSlice(dst []int, a, b []UUID) []int {
+	if len(b) < len(a) {
+		a = a[:len(b)]
+	}
+	for i := range a {
+		ahi, alo := uint128(a[i])
+		bhi, blo := uint128(b[i])
+		switch {
+		case ahi < bhi, ahi == bhi && alo < blo:
+			dst = append(dst, -1)
+		case ahi == bhi && alo == blo:
+			dst = append(dst, 0)
+		default:
+			dst = append(dst, 1)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestXORAll(t *testing.T) {
+	if got := XORAll(nil); got != Nil {
+		t.Errorf("XORAll(nil) = %s, want Nil", got)
+	}
+	uuids := []UUID{NameSpaceDNS, NameSpaceURL, NameSpaceOID}
+	var want UUID
+	for _, u := range uuids {
+		for i := range want {
+			want[i] ^= u[i]
+		}
+	}
+	if got := XORAll(uuids); got != want {
+		t.Errorf("XORAll = %s, want %s", got, want)
+	}
+	if got := XORAll([]UUID{NameSpaceOID, NameSpaceDNS, NameSpaceURL}); got != want {
+		t.Errorf("XORAll depends on order: %s != %s", got, want)
+	}
+	if got := XORAll([]UUID{NameSpaceDNS, NameSpaceDNS}); got != Nil {
+		t.Errorf("XORAll of duplicates = %s, want Nil", got)
+	}
+}
+
+func TestAndMask(t *testing.T) {
+	uuids := []UUID{Max, NameSpaceDNS}
+	AndMask(uuids, MustParse("ffffffff-0000-0000-0000-0000000000ff"))
+	want := []UUID{
+		MustParse("ffffffff-0000-0000-0000-0000000000ff"),
+		MustParse("6ba7b810-0000-0000-0000-0000000000c8"),
+	}
+	if !reflect.DeepEqual(uuids, want) {
+		t.Errorf("AndMask = %v, want %v", uuids, want)
+	}
+}
+
+func TestCompareSlice(t *testing.T) {
+	a := []UUID{Nil, NameSpaceDNS, Max, NameSpaceURL, MustParse("00000000-0000-0000-0000-000000000001")}
+	b := []UUID{Nil, NameSpaceURL, NameSpaceOID, NameSpaceURL, MustParse("00000000-0000-0000-0000-000000000002")}
+	got := CompareSlice(nil, a, b[:4])
+	if want := []int{0, -1, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CompareSlice = %v, want %v", got, want)
+	}
+	got = CompareSlice(got[:0], b, a)
+	for i := range got {
+		if want := Compare(b[i], a[i]); got[i] != want {
+			t.Errorf("CompareSlice[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+}
+
+func BenchmarkXORAll(b *testing.B) {
+	uuids := make([]UUID, 1024)
+	for i := range uuids {
+		uuids[i] = Must(NewRandom())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		XORAll(uuids)
+	}
+}
@@ -5,7 +5,9 @@
 package uuid
 
 import (
+	"fmt"
 	"io"
+	"time"
 )
 
 // UUID version 7 features a time-ordered value field derived from the widely
@@ -62,16 +64,23 @@ func makeV7(uuid []byte) {
 	_ = uuid[15] // bounds check
 
 	t, s := getV7Time()
+	putV7Time(uuid, t, s)
+}
+
+// putV7Time stores the 48 bits of milli in uuid[0] - uuid[5] and the 12 bits
+// of seq in rand_a, and sets the version to 7.
+func putV7Time(uuid []byte, milli, seq int64) {
+	_ = uuid[7] // bounds check
 
-	uuid[0] = byte(t >> 40)
-	uuid[1] = byte(t >> 32)
-	uuid[2] = byte(t >> 24)
-	uuid[3] = byte(t >> 16)
-	uuid[4] = byte(t >> 8)
-	uuid[5] = byte(t)
+	uuid[0] = byte(milli >> 40)
+	uuid[1] = byte(milli >> 32)
+	uuid[2] = byte(milli >> 24)
+	uuid[3] = byte(milli >> 16)
+	uuid[4] = byte(milli >> 8)
+	uuid[5] = byte(milli)
 
-	uuid[6] = 0x70 | (0x0F & byte(s>>8))
-	uuid[7] = byte(s)
+	uuid[6] = 0x70 | (0x0F & byte(seq>>8))
+	uuid[7] = byte(seq)
 }
 
 // lastV7time is the last time we returned stored as:
@@ -88,17 +97,73 @@ const nanoPerMilli = 1000000
 func getV7Time() (milli, seq int64) {
 	timeMu.Lock()
 	defer timeMu.Unlock()
+	return nextV7Time(&lastV7time, now().UnixNano())
+}
 
-	nano := timeNow().UnixNano()
+// NewV7Batch returns n Version 7 UUIDs, as n calls of NewV7 would, but reads
+// the clock only once.  The UUIDs are strictly increasing and greater than all
+// UUIDs previously returned by NewV7 and NewV7Batch.  If the sub-millisecond
+// counter of rand_a overflows within the batch, the following UUIDs continue
+// in the next millisecond, as NewV7 does for UUIDs generated faster than the
+// counter advances.  An error is returned if n is negative.
+func NewV7Batch(n int) ([]UUID, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("invalid UUID batch size: %d", n)
+	}
+	uuids := make([]UUID, n)
+	for i := range uuids {
+		var err error
+		if uuids[i], err = NewRandom(); err != nil {
+			return nil, err
+		}
+	}
+	if n == 0 {
+		return uuids, nil
+	}
+	timeMu.Lock()
+	milli, seq := nextV7Time(&lastV7time, now().UnixNano())
+	lastV7time += int64(n - 1)
+	timeMu.Unlock()
+	putV7Batch(uuids, milli<<12+seq)
+	return uuids, nil
+}
+
+// putV7Batch stores the consecutive times first, first+1, ... in the format
+// of lastV7time in uuids.
+func putV7Batch(uuids []UUID, first int64) {
+	for i := range uuids {
+		t := first + int64(i)
+		putV7Time(uuids[i][:], t>>12, t&0xfff)
+	}
+}
+
+// nextV7Time is the implementation of getV7Time for the time nano, using and
+// updating *last in place of lastV7time.  The caller must serialize calls
+// that share the same last.
+func nextV7Time(last *int64, nano int64) (milli, seq int64) {
 	milli = nano / nanoPerMilli
 	// Sequence number is between 0 and 3906 (nanoPerMilli>>8)
 	seq = (nano - milli*nanoPerMilli) >> 8
 	now := milli<<12 + seq
-	if now <= lastV7time {
-		now = lastV7time + 1
+	if now <= *last {
+		now = *last + 1
 		milli = now >> 12
 		seq = now & 0xfff
 	}
-	lastV7time = now
+	*last = now
 	return milli, seq
 }
+
+// V7Bounds returns the smallest and the largest Version 7 UUID with a time
+// between from and to, inclusive, in milliseconds.  A range scan between min
+// and max returns the Version 7 UUIDs of that time span.
+func V7Bounds(from, to time.Time) (min, max UUID) {
+	putV7Time(min[:], TimeToUnixMilli(from), 0)
+	min[8] = 0x80
+	putV7Time(max[:], TimeToUnixMilli(to), 0xfff)
+	max[8] = 0xbf
+	for i := 9; i < 16; i++ {
+		max[i] = 0xff
+	}
+	return min, max
+}
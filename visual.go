@@ -0,0 +1,96 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"crypto/sha256"
+	"strings"
+)
+
+// Size of the board of VisualHash, as in OpenSSH.
+const (
+	bishopWidth  = 17
+	bishopHeight = 9
+)
+
+// bishopSymbols are the symbols of the squares of the board of VisualHash by
+// the number of visits of the bishop.  The last two mark its start and end.
+const bishopSymbols = " .o+=*BOX@%&#/^SE"
+
+// VisualHash returns a picture of uuid, drawn as the random art of the key
+// fingerprints of OpenSSH, so that operators can confirm at a glance that two
+// UUIDs shown in dashboards or terminals are the same.  The picture of
+// f47ac10b-58cc-4372-a567-0e02b2c3d479 is
+//
+//	+-----------------+
+//	|                 |
+//	|                 |
+//	|                 |
+//	|    .   .. .     |
+//	|   . o oSo+ . . .|
+//	|    + . = oB *.o |
+//	|   o o = .BoX.=o.|
+//	|    B o o+.Eo*o.+|
+//	|   ..=   o+o.  +*|
+//	+-----------------+
+//
+// The picture is 11 lines of 19 characters, separated by newlines.  It is
+// drawn from the SHA-256 digest of uuid, by a "drunken bishop" starting at
+// the center of the board, marked S, and moving diagonally as directed by each
+// pair of bits of the digest to its end, marked E, so that UUIDs that differ
+// only in a few bits, such as Version 7 UUIDs generated in a row, have
+// unrelated pictures.  The pictures of distinct UUIDs may look alike: they
+// are not a substitute for comparing UUIDs in code.
+func (uuid UUID) VisualHash() string {
+	var board [bishopHeight][bishopWidth]byte
+	x, y := bishopWidth/2, bishopHeight/2
+	sum := sha256.Sum256(uuid[:])
+	for _, b := range sum {
+		for i := 0; i < 4; i++ {
+			if b&1 != 0 {
+				x++
+			} else {
+				x--
+			}
+			if b&2 != 0 {
+				y++
+			} else {
+				y--
+			}
+			x = clampInt(x, 0, bishopWidth-1)
+			y = clampInt(y, 0, bishopHeight-1)
+			if int(board[y][x]) < len(bishopSymbols)-3 {
+				board[y][x]++
+			}
+			b >>= 2
+		}
+	}
+	board[bishopHeight/2][bishopWidth/2] = byte(len(bishopSymbols) - 2)
+	board[y][x] = byte(len(bishopSymbols) - 1)
+
+	var sb strings.Builder
+	border := "+" + strings.Repeat("-", bishopWidth) + "+"
+	sb.WriteString(border)
+	for _, row := range board {
+		sb.WriteString("\n|")
+		for _, v := range row {
+			sb.WriteByte(bishopSymbols[v])
+		}
+		sb.WriteByte('|')
+	}
+	sb.WriteString("\n" + border)
+	return sb.String()
+}
+
+// clampInt returns v limited to the range [min, max].
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
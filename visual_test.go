@@ -0,0 +1,49 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVisualHash(t *testing.T) {
+	const want = `+-----------------+
+|                 |
+|                 |
+|                 |
+|    .   .. .     |
+|   . o oSo+ . . .|
+|    + . = oB *.o |
+|   o o = .BoX.=o.|
+|    B o o+.Eo*o.+|
+|   ..=   o+o.  +*|
++-----------------+`
+	u := MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	if got := u.VisualHash(); got != want {
+		t.Errorf("VisualHash() =\n%s\nwant\n%s", got, want)
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		v := Must(NewV7()).VisualHash()
+		lines := strings.Split(v, "\n")
+		if len(lines) != 11 {
+			t.Fatalf("VisualHash has %d lines, want 11:\n%s", len(lines), v)
+		}
+		for _, l := range lines {
+			if len(l) != 19 {
+				t.Fatalf("VisualHash has a line of %d characters, want 19:\n%s", len(l), v)
+			}
+		}
+		if strings.Count(v, "E") != 1 || strings.Count(v, "S") > 1 {
+			t.Errorf("VisualHash has a wrong start or end:\n%s", v)
+		}
+		if seen[v] {
+			t.Errorf("VisualHash repeated:\n%s", v)
+		}
+		seen[v] = true
+	}
+}
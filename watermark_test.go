@@ -0,0 +1,43 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "testing"
+
+func TestAttribution(t *testing.T) {
+	g, err := NewGenerator(WithInstanceID(0xbeef))
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := g.NewV7Batch(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuids := append([]UUID{Must(g.NewRandom()), Must(g.NewV7())}, batch...)
+	for _, u := range uuids {
+		if id, ok := Attribution(u); id != 0xbeef || !ok {
+			t.Errorf("Attribution(%s) = %#x, %t, want 0xbeef, true", u, id, ok)
+		}
+	}
+	u := uuids[1]
+	u[10] ^= 1
+	if _, ok := Attribution(u); ok {
+		t.Errorf("Attribution(%s) of a modified UUID succeeded", u)
+	}
+	if _, ok := Attribution(NewSHA1(NameSpaceDNS, []byte("example.com"))); ok {
+		t.Errorf("Attribution of a Version 5 UUID succeeded")
+	}
+
+	// About one in 65536 random UUIDs has a valid watermark.
+	n := 0
+	for i := 0; i < 1<<16; i++ {
+		if _, ok := Attribution(New()); ok {
+			n++
+		}
+	}
+	if n > 8 {
+		t.Errorf("%d of %d random UUIDs are watermarked", n, 1<<16)
+	}
+}
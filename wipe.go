@@ -0,0 +1,46 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+// Zero overwrites uuid with zeros, setting it to Nil, for applications that
+// treat identifiers as sensitive data, such as UUIDs embedding the time of a
+// medical record, and must not keep them in memory once used.  Zero cannot
+// wipe the copies of uuid the program made, such as its String.
+func (uuid *UUID) Zero() {
+	zero(uuid[:])
+}
+
+// SetRandPoolWipe sets whether the randomness pool enabled with EnableRandPool
+// overwrites with zeros the random bytes of each UUID as it is generated, so
+// that they do not linger in the pool.  DisableRandPool always wipes the pool.
+//
+// SetRandPoolWipe may be called concurrently with the generation of UUIDs.
+func SetRandPoolWipe(on bool) {
+	updateConfig(func(c *config) { c.poolWipe = on })
+}
+
+// WithBufferWipe makes the buffer of a Generator created with WithBufferSize
+// overwrite with zeros the random bytes of each UUID as it is generated, as
+// SetRandPoolWipe does for the package level randomness pool.
+func WithBufferWipe() GeneratorOption {
+	return func(g *Generator) {
+		g.bufWipe = true
+	}
+}
+
+// wipingReader reads from a BufferedReader, wiping the bytes it reads from
+// the buffer.
+type wipingReader struct{ b *BufferedReader }
+
+func (r wipingReader) Read(p []byte) (int, error) {
+	return r.b.read(p, true)
+}
+
+// zero overwrites b with zeros.
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
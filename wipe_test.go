@@ -0,0 +1,66 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestZero(t *testing.T) {
+	u := New()
+	u.Zero()
+	if u != Nil {
+		t.Errorf("Zero left %s", u)
+	}
+}
+
+func TestBufferedReaderWipe(t *testing.T) {
+	b := NewBufferedReader(&countingReader{next: 1}, 64)
+	var p [16]byte
+	b.Read(p[:]) //nolint:errcheck
+	if bytes.Equal(b.buf[:16], make([]byte, 16)) {
+		t.Fatalf("Read wiped the buffer without SetWipe")
+	}
+	b.SetWipe(true)
+	b.Read(p[:]) //nolint:errcheck
+	if !bytes.Equal(b.buf[16:32], make([]byte, 16)) {
+		t.Errorf("buffer after wiping Read: %x", b.buf[:32])
+	}
+	if bytes.Equal(p[:], make([]byte, 16)) {
+		t.Errorf("wiping Read returned zeros")
+	}
+	b.Reset()
+	if !bytes.Equal(b.buf, make([]byte, 64)) {
+		t.Errorf("buffer after Reset: %x", b.buf)
+	}
+}
+
+func TestRandPoolWipe(t *testing.T) {
+	defer Restore(Snapshot())
+	SetRand(&countingReader{next: 1})
+	DisableRandPool() // resets the pool
+	EnableRandPool()
+	SetRandPoolWipe(true)
+	u := New()
+	if u == Nil {
+		t.Fatal("New returned Nil")
+	}
+	pool.mu.Lock()
+	wiped := bytes.Equal(pool.buf[:16], make([]byte, 16)) && pool.buf[16] != 0
+	pool.mu.Unlock()
+	if !wiped {
+		t.Errorf("pool after New: %x", pool.buf[:32])
+	}
+
+	g, err := NewGenerator(WithRand(&countingReader{next: 1}), WithBufferSize(64), WithBufferWipe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.NewRandom() //nolint:errcheck
+	if buf := g.rand.(*BufferedReader).buf; !bytes.Equal(buf[:16], make([]byte, 16)) {
+		t.Errorf("Generator buffer after NewRandom: %x", buf[:32])
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import "encoding/binary"
+
+// FromXID returns the Version 7 UUID of the xid id (github.com/rs/xid).  The
+// 4 byte timestamp of id, in seconds, becomes the millisecond timestamp of
+// the UUID, and the 8 bytes of machine ID, process ID and counter are stored
+// in the random bits of the UUID, so the UUIDs of xids sort by time.  The
+// conversion is lossless: ToXID returns id.
+func FromXID(id [12]byte) UUID {
+	var uuid UUID
+	milli := uint64(binary.BigEndian.Uint32(id[:4])) * 1000
+	tail := binary.BigEndian.Uint64(id[4:])
+	uuid, _ = SetBits(uuid, 0, 48, milli)
+	uuid, _ = SetBits(uuid, 52, 12, tail>>52)
+	uuid, _ = SetBits(uuid, 66, 52, tail)
+	uuid[6] = uuid[6]&0x0f | 0x70 // Version 7
+	uuid[8] = uuid[8]&0x3f | 0x80 // Variant is 10
+	return uuid
+}
+
+// ToXID returns the xid of uuid, a Version 7 UUID, as FromXID stores it.  For
+// UUIDs not returned by FromXID the conversion is lossy: the milliseconds of
+// the timestamp and the last 10 random bits are dropped, and timestamps
+// beyond 2106 wrap around.  ToXID returns false if uuid is not a Version 7
+// UUID.
+func (uuid UUID) ToXID() ([12]byte, bool) {
+	var id [12]byte
+	if uuid.Version() != 7 {
+		return id, false
+	}
+	binary.BigEndian.PutUint32(id[:4], uint32(GetBits(uuid, 0, 48)/1000))
+	binary.BigEndian.PutUint64(id[4:], GetBits(uuid, 52, 12)<<52|GetBits(uuid, 66, 52))
+	return id, true
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Google Inc.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package uuid
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+func TestXID(t *testing.T) {
+	var id [12]byte
+	hex.Decode(id[:], []byte("4d88e15b60f486e428412dc9"))
+	uuid := FromXID(id)
+	if v := uuid.Version(); v != 7 {
+		t.Errorf("Version() = %d, want 7", v)
+	}
+	if v := uuid.Variant(); v != RFC4122 {
+		t.Errorf("Variant() = %v, want RFC4122", v)
+	}
+	sec, _ := uuid.Time().UnixTime()
+	if want := int64(0x4d88e15b); sec != want {
+		t.Errorf("time %d, want %d", sec, want)
+	}
+	if got, ok := uuid.ToXID(); !ok || got != id {
+		t.Errorf("ToXID() = %x, %v, want %x, true", got, ok, id)
+	}
+
+	// Lossy conversion of a Version 7 UUID.
+	v7 := MustParse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+	x, ok := v7.ToXID()
+	if !ok {
+		t.Fatalf("ToXID of %s failed", v7)
+	}
+	if got := FromXID(x); GetBits(got, 0, 48) != GetBits(v7, 0, 48)/1000*1000 {
+		t.Errorf("FromXID(ToXID(%s)) = %s, want time truncated to the second", v7, got)
+	}
+	if _, ok := NameSpaceDNS.ToXID(); ok {
+		t.Errorf("ToXID of a Version 1 UUID succeeded")
+	}
+}
+
+func TestSonyflake(t *testing.T) {
+	for _, id := range []uint64{0, 1<<63 - 1, 0x1a2b3c4d5e6f7a, 0x123456789abc} {
+		uuid := FromSonyflake(id)
+		if v := uuid.Version(); v != 7 {
+			t.Errorf("Version() = %d, want 7", v)
+		}
+		if got, ok := uuid.ToSonyflake(); !ok || got != id {
+			t.Errorf("ToSonyflake(FromSonyflake(%#x)) = %#x, %v", id, got, ok)
+		}
+	}
+	uuid := FromSonyflake(100 << 24)
+	sec, nsec := uuid.Time().UnixTime()
+	if got, want := time.Unix(sec, nsec).UTC(), time.Date(2014, 9, 1, 0, 0, 1, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("time %v, want %v", got, want)
+	}
+	before := MustParse("00000000-0000-7000-8000-000000000000")
+	if _, ok := before.ToSonyflake(); ok {
+		t.Errorf("ToSonyflake of a time before the epoch succeeded")
+	}
+}